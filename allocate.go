@@ -0,0 +1,161 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// validateRatios checks that ratios is non-empty, has no negative entries and
+// does not sum to zero.
+func validateRatios(ratios []int) error {
+	if len(ratios) == 0 {
+		return fmt.Errorf("allocation ratios must not be empty")
+	}
+	total := 0
+	for i, r := range ratios {
+		if r < 0 {
+			return fmt.Errorf("allocation ratio %d is negative: %d", i, r)
+		}
+		total += r
+	}
+	if total == 0 {
+		return fmt.Errorf("allocation ratios must not all be zero")
+	}
+	return nil
+}
+
+// splitUnits distributes total whole units across ratios. Each share is
+// total*ratio/sum truncated toward zero, and the units lost to truncation are
+// handed out one at a time to the buckets with a positive ratio, first bucket
+// first, so the shares always add up to total exactly.
+func splitUnits(total *big.Int, ratios []int) []*big.Int {
+	sum := big.NewInt(0)
+	for _, r := range ratios {
+		sum.Add(sum, big.NewInt(int64(r)))
+	}
+
+	shares := make([]*big.Int, len(ratios))
+	leftover := new(big.Int).Set(total)
+	for i, r := range ratios {
+		shares[i] = new(big.Int).Mul(total, big.NewInt(int64(r)))
+		shares[i].Quo(shares[i], sum)
+		leftover.Sub(leftover, shares[i])
+	}
+
+	unit := big.NewInt(int64(leftover.Sign()))
+	for i := 0; leftover.Sign() != 0; i++ {
+		if ratios[i] == 0 {
+			continue
+		}
+		shares[i].Add(shares[i], unit)
+		leftover.Sub(leftover, unit)
+	}
+	return shares
+}
+
+// Allocate splits d across len(ratios) parts in proportion to ratios without
+// losing a single minor unit: the scale of d is the granularity, every part
+// carries it, and the parts always sum to d exactly. Each part first gets its
+// proportional share truncated toward zero, then the units left over are
+// handed out one at a time from the first bucket with a positive ratio, so
+// 100.00 split 1:1:1 gives [33.34, 33.33, 33.33]. Negative amounts are split
+// the same way with negative parts.
+//
+// It returns an error if ratios is empty, contains a negative ratio or sums
+// to zero.
+func (d Decimal) Allocate(ratios []int) ([]Decimal, error) {
+	d = d.orZero()
+	if err := validateRatios(ratios); err != nil {
+		return nil, err
+	}
+	shares := splitUnits(d.unscaledValue, ratios)
+	parts := make([]Decimal, len(shares))
+	for i, share := range shares {
+		parts[i] = Decimal{unscaledValue: share, scale: d.scale}
+	}
+	return parts, nil
+}
+
+// AllocateWithCaps splits d across buckets in proportion to ratios, without
+// letting any bucket exceed its cap. A bucket whose proportional share would
+// pass its cap receives exactly the cap, and the excess is redistributed
+// among the remaining buckets by their ratios, repeating until every share
+// fits. The scale of d is the minor-unit granularity: parts carry d's scale,
+// caps are truncated to it, and the parts always sum to d exactly.
+//
+// It returns an error if the ratios are invalid, if caps and ratios differ in
+// length, if d or any cap is negative, or if d exceeds what the buckets with a
+// positive ratio can hold.
+func (d Decimal) AllocateWithCaps(ratios []int, caps []Decimal) ([]Decimal, error) {
+	d = d.orZero()
+	if err := validateRatios(ratios); err != nil {
+		return nil, err
+	}
+	if len(caps) != len(ratios) {
+		return nil, fmt.Errorf("got %d caps for %d allocation ratios", len(caps), len(ratios))
+	}
+	if d.IsNegative() {
+		return nil, fmt.Errorf("cannot allocate negative amount %s with caps", d)
+	}
+
+	capUnits := make([]*big.Int, len(caps))
+	capTotal := new(big.Int)
+	for i, c := range caps {
+		if c.IsNegative() {
+			return nil, fmt.Errorf("allocation cap %d is negative: %s", i, c)
+		}
+		capUnits[i] = c.Truncate(d.scale).rescale(d.scale).unscaledValue
+		capTotal.Add(capTotal, capUnits[i])
+	}
+	if d.unscaledValue.Cmp(capTotal) > 0 {
+		return nil, fmt.Errorf("amount %s exceeds the sum of caps %s", d, Decimal{unscaledValue: capTotal, scale: d.scale})
+	}
+
+	units := make([]*big.Int, len(ratios))
+	active := append([]int(nil), ratios...)
+	remaining := new(big.Int).Set(d.unscaledValue)
+	for {
+		open := false
+		for _, r := range active {
+			if r > 0 {
+				open = true
+				break
+			}
+		}
+		if !open {
+			if remaining.Sign() != 0 {
+				return nil, fmt.Errorf("cannot allocate remaining %s: every bucket with a positive ratio is capped",
+					Decimal{unscaledValue: remaining, scale: d.scale})
+			}
+			break
+		}
+
+		shares := splitUnits(remaining, active)
+		capped := false
+		for i, share := range shares {
+			if active[i] > 0 && share.Cmp(capUnits[i]) > 0 {
+				units[i] = capUnits[i]
+				remaining.Sub(remaining, capUnits[i])
+				active[i] = 0
+				capped = true
+			}
+		}
+		if !capped {
+			for i, share := range shares {
+				if active[i] > 0 {
+					units[i] = share
+				}
+			}
+			break
+		}
+	}
+
+	parts := make([]Decimal, len(units))
+	for i, u := range units {
+		if u == nil {
+			u = new(big.Int)
+		}
+		parts[i] = Decimal{unscaledValue: u, scale: d.scale}
+	}
+	return parts, nil
+}
@@ -0,0 +1,141 @@
+package decimal
+
+import (
+	"testing"
+)
+
+func TestDecimal_Allocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  string
+		ratios  []int
+		want    []string
+		wantErr bool
+	}{
+		{"thirds", "100.00", []int{1, 1, 1}, []string{"33.34", "33.33", "33.33"}, false},
+		{"weighted", "100.00", []int{3, 1}, []string{"75.00", "25.00"}, false},
+		{"two leftover units", "0.05", []int{1, 1, 1}, []string{"0.02", "0.02", "0.01"}, false},
+		{"zero ratio skipped", "0.03", []int{0, 1, 1}, []string{"0.00", "0.02", "0.01"}, false},
+		{"single part", "12.34", []int{7}, []string{"12.34"}, false},
+		{"negative amount", "-100.00", []int{1, 1, 1}, []string{"-33.34", "-33.33", "-33.33"}, false},
+		{"whole units", "10", []int{1, 2}, []string{"4", "6"}, false},
+		{"negative scale granularity", "1e+3", []int{1, 1, 1}, []string{"1000", "0", "0"}, false},
+		{"empty ratios", "1.00", []int{}, nil, true},
+		{"all zero ratios", "1.00", []int{0, 0}, nil, true},
+		{"negative ratio", "1.00", []int{2, -1}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, err := NewFromString(tt.amount)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.amount, err)
+			}
+			got, err := amount.Allocate(tt.ratios)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Allocate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Allocate() = %v, want %v", got, tt.want)
+			}
+			sum := New(0, amount.scale)
+			for i := range got {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("Allocate()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+				if got[i].scale != amount.scale {
+					t.Errorf("Allocate()[%d] scale = %d, want %d", i, got[i].scale, amount.scale)
+				}
+				sum = sum.Add(got[i])
+			}
+			if !sum.Equal(amount) {
+				t.Errorf("Allocate() parts sum to %v, want %v", sum, amount)
+			}
+		})
+	}
+}
+
+func TestDecimal_AllocateSumsToWhole(t *testing.T) {
+	ratios := [][]int{{1, 1, 1}, {1, 2, 3, 4}, {7, 0, 13}, {1, 1, 1, 1, 1, 1, 1}}
+	for cents := int64(-250); cents <= 250; cents += 7 {
+		amount := New(cents, 2)
+		for _, r := range ratios {
+			parts, err := amount.Allocate(r)
+			if err != nil {
+				t.Fatalf("Allocate(%v) unexpected error: %v", r, err)
+			}
+			sum := New(0, 2)
+			for _, p := range parts {
+				sum = sum.Add(p)
+			}
+			if !sum.Equal(amount) {
+				t.Errorf("Allocate(%v) of %v sums to %v", r, amount, sum)
+			}
+		}
+	}
+}
+
+func TestDecimal_AllocateWithCaps(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  string
+		ratios  []int
+		caps    []string
+		want    []string
+		wantErr bool
+	}{
+		{"no cap reached", "100.00", []int{1, 1, 1}, []string{"50", "50", "50"}, []string{"33.34", "33.33", "33.33"}, false},
+		{"one bucket capped", "100.00", []int{1, 1, 1}, []string{"10", "50", "50"}, []string{"10.00", "45.00", "45.00"}, false},
+		{"cascade", "100.00", []int{2, 1, 1}, []string{"30", "40", "100"}, []string{"30.00", "35.00", "35.00"}, false},
+		{"second pass caps again", "100.00", []int{1, 1, 1}, []string{"10", "35", "100"}, []string{"10.00", "35.00", "55.00"}, false},
+		{"exactly at caps", "60.00", []int{1, 2, 3}, []string{"10", "20", "30"}, []string{"10.00", "20.00", "30.00"}, false},
+		{"zero ratio bucket", "10.00", []int{1, 0, 1}, []string{"100", "100", "100"}, []string{"5.00", "0.00", "5.00"}, false},
+		{"fractional cap truncated", "10.00", []int{1, 1}, []string{"3.339", "100"}, []string{"3.33", "6.67"}, false},
+		{"exceeds caps", "100.00", []int{1, 1}, []string{"40", "50"}, nil, true},
+		{"only zero-ratio capacity left", "100.00", []int{1, 0}, []string{"40", "100"}, nil, true},
+		{"caps length mismatch", "100.00", []int{1, 1}, []string{"100"}, nil, true},
+		{"negative cap", "1.00", []int{1, 1}, []string{"-1", "5"}, nil, true},
+		{"negative amount", "-1.00", []int{1}, []string{"5"}, nil, true},
+		{"empty ratios", "1.00", []int{}, []string{}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, err := NewFromString(tt.amount)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.amount, err)
+			}
+			caps := make([]Decimal, len(tt.caps))
+			for i, c := range tt.caps {
+				if caps[i], err = NewFromString(c); err != nil {
+					t.Fatalf("NewFromString(%q) unexpected error: %v", c, err)
+				}
+			}
+
+			got, err := amount.AllocateWithCaps(tt.ratios, caps)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AllocateWithCaps() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("AllocateWithCaps() = %v, want %v", got, tt.want)
+			}
+			sum := New(0, amount.scale)
+			for i := range got {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("AllocateWithCaps()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+				if got[i].GreaterThan(caps[i]) {
+					t.Errorf("AllocateWithCaps()[%d] = %v exceeds cap %v", i, got[i], caps[i])
+				}
+				sum = sum.Add(got[i])
+			}
+			if !sum.Equal(amount) {
+				t.Errorf("AllocateWithCaps() parts sum to %v, want %v", sum, amount)
+			}
+		})
+	}
+}
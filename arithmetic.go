@@ -0,0 +1,344 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Add returns d + other. The result is exact and carries the larger of the
+// two scales.
+func (d Decimal) Add(other Decimal) Decimal {
+	d, other = d.orZero(), other.orZero()
+	if d.scale == other.scale {
+		// Common for fixed-scale money columns: no operand needs rescaling
+		return Decimal{
+			unscaledValue: new(big.Int).Add(d.unscaledValue, other.unscaledValue),
+			scale:         d.scale,
+		}
+	}
+	x, y, scratch, scale := alignCoefficients(d, other)
+	defer putScratch(scratch)
+	return Decimal{
+		unscaledValue: new(big.Int).Add(x, y),
+		scale:         scale,
+	}
+}
+
+// Multiply returns d * other. The result is exact and its scale is the sum
+// of the two scales. It panics if that sum overflows int32; ProductCapped
+// reports the same condition as an error.
+func (d Decimal) Multiply(other Decimal) Decimal {
+	d, other = d.orZero(), other.orZero()
+	scale, err := productScale(d.scale, other.scale)
+	if err != nil {
+		panic(fmt.Sprintf("Multiply: %v", err))
+	}
+	return Decimal{
+		unscaledValue: new(big.Int).Mul(d.unscaledValue, other.unscaledValue),
+		scale:         scale,
+	}
+}
+
+// FMA returns d*mul + add, exactly and without intermediate rounding. The
+// result carries the larger of the product's scale, d.scale+mul.scale, and
+// add's scale, as Multiply followed by Add would, but the sum is formed in
+// the product's buffer. It panics like Multiply if the product's scale
+// overflows.
+func (d Decimal) FMA(mul, add Decimal) Decimal {
+	d, mul, add = d.orZero(), mul.orZero(), add.orZero()
+	mulScale, err := productScale(d.scale, mul.scale)
+	if err != nil {
+		panic(fmt.Sprintf("FMA: %v", err))
+	}
+	scale := max(mulScale, add.scale)
+
+	r := new(big.Int).Mul(d.unscaledValue, mul.unscaledValue)
+	if mulScale < scale {
+		r.Mul(r, pow10(scale-mulScale))
+	}
+	if add.scale < scale {
+		scratch := getScratch()
+		r.Add(r, scratch.Mul(add.unscaledValue, pow10(scale-add.scale)))
+		putScratch(scratch)
+	} else {
+		r.Add(r, add.unscaledValue)
+	}
+	return Decimal{unscaledValue: r, scale: scale}
+}
+
+// productScale returns a + b, or an error if the sum overflows int32.
+func productScale(a, b int32) (int32, error) {
+	scale := int64(a) + int64(b)
+	if scale > math.MaxInt32 || scale < math.MinInt32 {
+		return 0, fmt.Errorf("%w: %d is out of int32 range", ErrScaleOverflow, scale)
+	}
+	return int32(scale), nil
+}
+
+// Sub returns d - other. The result is exact and carries the larger of the
+// two scales.
+func (d Decimal) Sub(other Decimal) Decimal {
+	d, other = d.orZero(), other.orZero()
+	if d.scale == other.scale {
+		return Decimal{
+			unscaledValue: new(big.Int).Sub(d.unscaledValue, other.unscaledValue),
+			scale:         d.scale,
+		}
+	}
+	x, y, scratch, scale := alignCoefficients(d, other)
+	defer putScratch(scratch)
+	return Decimal{
+		unscaledValue: new(big.Int).Sub(x, y),
+		scale:         scale,
+	}
+}
+
+// Neg returns -d at the same scale.
+func (d Decimal) Neg() Decimal {
+	d = d.orZero()
+	return Decimal{
+		unscaledValue: new(big.Int).Neg(d.unscaledValue),
+		scale:         d.scale,
+	}
+}
+
+// NegIf returns d.Neg() when cond is true and d unchanged otherwise, which
+// keeps sign flips for debit/credit entries free of conditionals:
+//
+//	amount.NegIf(account.IsCredit())
+func (d Decimal) NegIf(cond bool) Decimal {
+	if cond {
+		return d.Neg()
+	}
+	return d
+}
+
+// Abs returns the absolute value of d at the same scale.
+func (d Decimal) Abs() Decimal {
+	d = d.orZero()
+	if d.unscaledValue.Sign() >= 0 {
+		return d
+	}
+	return d.Neg()
+}
+
+// Shift moves the decimal point of d by places: positive places multiply by
+// 10^places and negative places divide by 10^-places, so 1.23 shifted by 2 is
+// 123 and by -2 is 0.0123. Only the scale changes, so the result is always
+// exact and shares d's coefficient. It panics if the new scale does not fit
+// in an int32.
+func (d Decimal) Shift(places int32) Decimal {
+	d = d.orZero()
+	scale := int64(d.scale) - int64(places)
+	if scale < math.MinInt32 || scale > math.MaxInt32 {
+		panic(fmt.Sprintf("Shift: scale %d shifted by %d is out of range", d.scale, places))
+	}
+	return Decimal{
+		unscaledValue: d.unscaledValue,
+		scale:         int32(scale),
+	}
+}
+
+// maxNormalizeShift bounds how many digits NormalizeExponent moves into the
+// coefficient in one call, so a far-off target cannot force a huge power of ten.
+const maxNormalizeShift = 1024
+
+// NormalizeExponent moves any exponent below targetMinScale into the
+// coefficient, so that the result has a scale of at least targetMinScale.
+// Values parsed from scientific notation such as "1.23e+5" carry a negative
+// scale (-3); normalizing them gives them the same representation as the
+// values they are combined with. At most maxNormalizeShift digits are moved
+// per call: if targetMinScale is further away than that the scale is raised
+// by maxNormalizeShift only and stays below targetMinScale. The value is
+// unchanged and values whose scale is already at or above targetMinScale are
+// returned as is.
+func (d Decimal) NormalizeExponent(targetMinScale int32) Decimal {
+	if d.scale >= targetMinScale {
+		return d
+	}
+	if int64(targetMinScale)-int64(d.scale) > maxNormalizeShift {
+		targetMinScale = d.scale + maxNormalizeShift
+	}
+	return d.rescale(targetMinScale)
+}
+
+// quoRat returns the exact quotient d / other as a big.Rat, or an error if
+// other is zero.
+func (d Decimal) quoRat(other Decimal) (*big.Rat, error) {
+	d, other = d.orZero(), other.orZero()
+	if other.unscaledValue.Sign() == 0 {
+		return nil, ErrDivisionByZero
+	}
+	return new(big.Rat).Quo(d.Rat(), other.Rat()), nil
+}
+
+// Divide returns d / other rounded to precision fractional digits using
+// mode. The result always has scale precision, so 1 / 4 at precision 10 is
+// 0.2500000000. It returns an error if other is zero, if precision is
+// negative, or if mode is RoundUnnecessary and the quotient does not
+// terminate within precision digits.
+func (d Decimal) Divide(other Decimal, precision int32, mode RoundingMode) (Decimal, error) {
+	quotient, err := d.quoRat(other)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return NewFromRat(quotient, precision, mode)
+}
+
+// DivideTrim is like Divide, but when the quotient terminates within
+// precision digits it is returned at its natural scale instead of being
+// padded: 1 / 4 at precision 10 is 0.25 rather than 0.2500000000. Trailing
+// zeros are removed down to the preferred scale d.scale - other.scale, and
+// never below scale 0. Quotients that had to be rounded, such as 1 / 3, keep
+// the full precision.
+func (d Decimal) DivideTrim(other Decimal, precision int32, mode RoundingMode) (Decimal, error) {
+	quotient, err := d.quoRat(other)
+	if err != nil {
+		return Decimal{}, err
+	}
+	result, err := NewFromRat(quotient, precision, mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+	if result.Rat().Cmp(quotient) != 0 {
+		return result, nil
+	}
+	return result.trimTrailingZeros(max(d.scale-other.scale, 0)), nil
+}
+
+// alignedQuoRem aligns d and other to a common scale and returns the
+// truncated quotient and remainder of their unscaled values, along with the
+// aligned divisor. It returns an error if other is zero.
+func (d Decimal) alignedQuoRem(other Decimal) (quo, rem, divisor *big.Int, err error) {
+	d, other = d.orZero(), other.orZero()
+	if other.unscaledValue.Sign() == 0 {
+		return nil, nil, nil, ErrDivisionByZero
+	}
+	scale := max(d.scale, other.scale)
+	dividend := d.rescale(scale).unscaledValue
+	divisor = other.rescale(scale).unscaledValue
+
+	quo, rem = new(big.Int).QuoRem(dividend, divisor, new(big.Int))
+	return quo, rem, divisor, nil
+}
+
+// FloorDiv returns the integer quotient d / other rounded toward negative
+// infinity, e.g. 7 / 2 = 3 and -7 / 2 = -4. It answers "how many whole
+// buckets of size other fit into d" and returns an error if other is zero.
+func (d Decimal) FloorDiv(other Decimal) (*big.Int, error) {
+	quo, rem, divisor, err := d.alignedQuoRem(other)
+	if err != nil {
+		return nil, fmt.Errorf("FloorDiv: %w", err)
+	}
+	// QuoRem truncates toward zero; step down when the exact quotient is negative
+	if rem.Sign() != 0 && rem.Sign() != divisor.Sign() {
+		quo.Sub(quo, big.NewInt(1))
+	}
+	return quo, nil
+}
+
+// CeilDiv returns the integer quotient d / other rounded toward positive
+// infinity, e.g. 7 / 2 = 4 and -7 / 2 = -3. It returns an error if other is zero.
+func (d Decimal) CeilDiv(other Decimal) (*big.Int, error) {
+	quo, rem, divisor, err := d.alignedQuoRem(other)
+	if err != nil {
+		return nil, fmt.Errorf("CeilDiv: %w", err)
+	}
+	// QuoRem truncates toward zero; step up when the exact quotient is positive
+	if rem.Sign() != 0 && rem.Sign() == divisor.Sign() {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return quo, nil
+}
+
+// DivideToIntegerValue returns the integer part of d / other, truncated
+// toward zero, as a scale-0 Decimal: 7.5 / 2 is 3 and -7.5 / 2 is -3. This is
+// the General Decimal Arithmetic divide-integer operation, and
+// d - other·DivideToIntegerValue(other) is the remainder with d's sign. It
+// returns an error if other is zero.
+func (d Decimal) DivideToIntegerValue(other Decimal) (Decimal, error) {
+	quo, _, _, err := d.alignedQuoRem(other)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("DivideToIntegerValue: %w", err)
+	}
+	return Decimal{unscaledValue: quo, scale: 0}, nil
+}
+
+// PercentDiff returns the symmetric percentage difference between a and b,
+//
+//	|a - b| / ((|a| + |b|) / 2) * 100
+//
+// rounded to precision fractional digits using mode. Unlike a percent change
+// it does not depend on which value is taken as the base, so
+// PercentDiff(a, b) == PercentDiff(b, a). It returns an error if both a and
+// b are zero.
+func PercentDiff(a, b Decimal, precision int32, mode RoundingMode) (Decimal, error) {
+	sum := a.Abs().Add(b.Abs())
+	if sum.IsZero() {
+		return Decimal{}, fmt.Errorf("percent difference is undefined when both values are zero")
+	}
+
+	// 200 * |a - b| / (|a| + |b|), kept exact until the final rounding
+	ratio := new(big.Rat).Quo(a.Sub(b).Abs().Rat(), sum.Rat())
+	ratio.Mul(ratio, big.NewRat(200, 1))
+	return NewFromRat(ratio, precision, mode)
+}
+
+// PercentChange returns the change from from to to as a percentage,
+//
+//	(to - from) / from * 100
+//
+// rounded to precision fractional digits using mode: 80 to 90 is 12.50 at
+// precision 2 and 90 to 80 is -11.11. The base is from as given, so the sign
+// flips when from is negative. It returns an error wrapping
+// ErrDivisionByZero if from is zero.
+func PercentChange(from, to Decimal, precision int32, mode RoundingMode) (Decimal, error) {
+	if from.IsZero() {
+		return Decimal{}, fmt.Errorf("percent change from zero: %w", ErrDivisionByZero)
+	}
+
+	// 100 * (to - from) / from, kept exact until the final rounding
+	ratio := new(big.Rat).Quo(to.Sub(from).Rat(), from.Rat())
+	ratio.Mul(ratio, big.NewRat(100, 1))
+	return NewFromRat(ratio, precision, mode)
+}
+
+// ProductCapped multiplies values together, rounding the running product to
+// maxScale fractional digits with mode whenever a multiplication pushes the
+// scale beyond it. This keeps long chains of fractional factors (growth
+// rates, discount ladders) from accumulating ever larger scales. An empty
+// list yields 1. It returns an error if a multiplication would overflow the
+// int32 scale before it can be capped, or if mode is RoundUnnecessary and
+// capping would drop non-zero digits.
+func ProductCapped(maxScale int32, mode RoundingMode, values ...Decimal) (Decimal, error) {
+	product := New(1, 0)
+	for i, v := range values {
+		if _, err := productScale(product.scale, v.scale); err != nil {
+			return Decimal{}, fmt.Errorf("multiplying factor %d: %w", i, err)
+		}
+		product = product.Multiply(v)
+		if product.scale <= maxScale {
+			continue
+		}
+		if mode == RoundUnnecessary && product.trimTrailingZeros(maxScale).scale > maxScale {
+			return Decimal{}, fmt.Errorf("%w to cap factor %d at scale %d with RoundUnnecessary mode", ErrRoundingNecessary, i, maxScale)
+		}
+		product = roundToCap(product, maxScale, mode)
+	}
+	return product, nil
+}
+
+// roundToCap rounds d to maxScale like Round, without computing a huge power
+// of ten when every digit of the coefficient is dropped. In that case |d| is
+// below a tenth of the last retained unit, so the result depends only on the
+// sign and the mode, and rounding the same coefficient placed one digit
+// below maxScale gives the identical answer.
+func roundToCap(d Decimal, maxScale int32, mode RoundingMode) Decimal {
+	d = d.orZero()
+	digits := int64(numDigits(d.unscaledValue))
+	if int64(d.scale)-int64(maxScale) > digits+1 {
+		d = Decimal{unscaledValue: d.unscaledValue, scale: maxScale + int32(digits) + 1}
+	}
+	return d.Round(maxScale, mode)
+}
@@ -0,0 +1,660 @@
+package decimal
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestDecimal_Add(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         Decimal
+		b         Decimal
+		wantVal   string
+		wantScale int32
+	}{
+		{"same scale", New(125, 2), New(275, 2), "400", 2},
+		{"mixed scales", New(15, 1), New(125, 3), "1625", 3},
+		{"negative", New(-15, 1), New(5, 1), "-10", 1},
+		{"negative scale", New(1, -2), New(5, 1), "1005", 1},
+		{"zero", New(0, 0), New(25, 1), "25", 1},
+		{"same negative scale", New(3, -2), New(-5, -2), "-2", -2},
+		{"zero values", Decimal{}, Decimal{}, "0", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.a.Add(tt.b)
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+				t.Errorf("Add() = %v scale %d, want %v scale %d", got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+			}
+		})
+	}
+}
+
+func TestDecimal_AddSubEqualScaleDoNotAlias(t *testing.T) {
+	a, b := New(125, 2), New(0, 2)
+	for name, got := range map[string]Decimal{"Add": a.Add(b), "Sub": a.Sub(b)} {
+		got.unscaledValue.SetInt64(9)
+		if a.String() != "1.25" || b.String() != "0.00" {
+			t.Errorf("mutating the result of %s changed its operands to %v and %v", name, a, b)
+		}
+	}
+}
+
+func TestDecimal_AddSubMixedScaleKeepResults(t *testing.T) {
+	// The aligned operand is pooled scratch; results must not share it
+	var sums, diffs []Decimal
+	for i := int64(0); i < 50; i++ {
+		sums = append(sums, New(i, 0).Add(New(5, 1)))
+		diffs = append(diffs, New(5, 1).Sub(New(i, 0)))
+	}
+	for i, got := range sums {
+		if want := New(10*int64(i)+5, 1); !got.StrictEqual(want) {
+			t.Errorf("sums[%d] = %v, want %v", i, got, want)
+		}
+		if want := New(5-10*int64(i), 1); !diffs[i].StrictEqual(want) {
+			t.Errorf("diffs[%d] = %v, want %v", i, diffs[i], want)
+		}
+	}
+}
+
+func TestDecimal_NormalizeExponent(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		target    int32
+		wantVal   string
+		wantScale int32
+	}{
+		{"scientific to integer", "1.23e+5", 0, "123000", 0},
+		{"scientific to cents", "1.23e+5", 2, "12300000", 2},
+		{"already normal", "1.5", 0, "15", 1},
+		{"negative", "-4e+3", 0, "-4000", 0},
+		{"partial target", "7e+6", -3, "7000", -3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.NormalizeExponent(tt.target)
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+				t.Errorf("NormalizeExponent(%d) = %v scale %d, want %v scale %d",
+					tt.target, got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+			}
+			if !got.Equal(d) {
+				t.Errorf("NormalizeExponent(%d) changed the value of %s", tt.target, tt.input)
+			}
+		})
+	}
+}
+
+func TestDecimal_NormalizeExponentBounded(t *testing.T) {
+	tests := []struct {
+		name      string
+		d         Decimal
+		target    int32
+		wantScale int32
+	}{
+		{"far positive target", New(1, -5), 1 << 30, maxNormalizeShift - 5},
+		{"max target", New(1, math.MinInt32), math.MaxInt32, math.MinInt32 + maxNormalizeShift},
+		{"exactly at bound", New(1, -maxNormalizeShift), 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.NormalizeExponent(tt.target)
+			if got.scale != tt.wantScale {
+				t.Errorf("NormalizeExponent(%d) scale = %d, want %d", tt.target, got.scale, tt.wantScale)
+			}
+			// The coefficient grows by at most maxNormalizeShift digits
+			if digits := len(got.unscaledValue.String()); digits > len(tt.d.unscaledValue.String())+maxNormalizeShift {
+				t.Errorf("NormalizeExponent(%d) coefficient has %d digits", tt.target, digits)
+			}
+			if got.unscaledValue.Cmp(new(big.Int).Mul(tt.d.unscaledValue, pow10(got.scale-tt.d.scale))) != 0 {
+				t.Errorf("NormalizeExponent(%d) changed the value", tt.target)
+			}
+		})
+	}
+}
+
+func TestDecimal_FloorDivCeilDiv(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         Decimal
+		b         Decimal
+		wantFloor string
+		wantCeil  string
+	}{
+		{"7 / 2", New(7, 0), New(2, 0), "3", "4"},
+		{"-7 / 2", New(-7, 0), New(2, 0), "-4", "-3"},
+		{"7 / -2", New(7, 0), New(-2, 0), "-4", "-3"},
+		{"-7 / -2", New(-7, 0), New(-2, 0), "3", "4"},
+		{"exact", New(8, 0), New(2, 0), "4", "4"},
+		{"negative exact", New(-8, 0), New(2, 0), "-4", "-4"},
+		{"10.5 / 2.5", New(105, 1), New(25, 1), "4", "5"},
+		{"mixed scales", New(1, 0), New(3, 2), "33", "34"},
+		{"smaller than divisor", New(1, 1), New(1, 0), "0", "1"},
+		{"negative smaller than divisor", New(-1, 1), New(1, 0), "-1", "0"},
+		{"negative scale", New(1, -2), New(7, 0), "14", "15"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			floor, err := tt.a.FloorDiv(tt.b)
+			if err != nil {
+				t.Fatalf("FloorDiv() unexpected error: %v", err)
+			}
+			if floor.String() != tt.wantFloor {
+				t.Errorf("FloorDiv() = %v, want %v", floor, tt.wantFloor)
+			}
+			ceil, err := tt.a.CeilDiv(tt.b)
+			if err != nil {
+				t.Fatalf("CeilDiv() unexpected error: %v", err)
+			}
+			if ceil.String() != tt.wantCeil {
+				t.Errorf("CeilDiv() = %v, want %v", ceil, tt.wantCeil)
+			}
+		})
+	}
+}
+
+func TestDecimal_FloorDivCeilDivZero(t *testing.T) {
+	if _, err := New(7, 0).FloorDiv(New(0, 2)); err == nil {
+		t.Error("FloorDiv() by zero did not return an error")
+	}
+	if _, err := New(7, 0).CeilDiv(New(0, 0)); err == nil {
+		t.Error("CeilDiv() by zero did not return an error")
+	}
+}
+
+func TestDecimal_DivideToIntegerValue(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Decimal
+		b    Decimal
+		want string
+	}{
+		{"7.5 / 2", New(75, 1), New(2, 0), "3"},
+		{"-7.5 / 2", New(-75, 1), New(2, 0), "-3"},
+		{"7.5 / -2", New(75, 1), New(-2, 0), "-3"},
+		{"-7.5 / -2", New(-75, 1), New(-2, 0), "3"},
+		{"exact", New(9, 0), New(3, 0), "3"},
+		{"smaller than divisor", New(-1, 1), New(1, 0), "0"},
+		{"fractional divisor", New(1, 0), New(3, 2), "33"},
+		{"negative scale", New(1, -3), New(7, 0), "142"},
+		{"zero dividend", New(0, 5), New(7, 1), "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.a.DivideToIntegerValue(tt.b)
+			if err != nil {
+				t.Fatalf("DivideToIntegerValue() unexpected error: %v", err)
+			}
+			if got.String() != tt.want || got.scale != 0 {
+				t.Errorf("DivideToIntegerValue() = %v scale %d, want %v scale 0", got, got.scale, tt.want)
+			}
+			// The remainder keeps the dividend's sign and is smaller than the divisor
+			rem := tt.a.Sub(tt.b.Multiply(got))
+			if rem.Abs().Cmp(tt.b.Abs()) >= 0 || (rem.Sign() != 0 && rem.Sign() != tt.a.Sign()) {
+				t.Errorf("remainder %v of %v / %v is out of range", rem, tt.a, tt.b)
+			}
+		})
+	}
+	if _, err := New(7, 0).DivideToIntegerValue(New(0, 3)); err == nil {
+		t.Error("DivideToIntegerValue() by zero did not return an error")
+	}
+}
+
+func TestDecimal_Shift(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Decimal
+		places    int32
+		want      string
+		wantScale int32
+	}{
+		{"left", New(123, 2), 2, "123", 0},
+		{"right", New(123, 2), -2, "0.0123", 4},
+		{"none", New(123, 2), 0, "1.23", 2},
+		{"past the point", New(5, 0), 3, "5000", -3},
+		{"negative", New(-15, 1), -1, "-0.15", 2},
+		{"zero", New(0, 2), 5, "0", -3},
+		{"zero right", New(0, 0), -3, "0.000", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.Shift(tt.places)
+			if got.String() != tt.want || got.scale != tt.wantScale {
+				t.Errorf("Shift(%d) = %v scale %d, want %v scale %d", tt.places, got, got.scale, tt.want, tt.wantScale)
+			}
+			if back := got.Shift(-tt.places); !back.StrictEqual(tt.input) {
+				t.Errorf("Shift(%d).Shift(%d) = %v, want %v", tt.places, -tt.places, back, tt.input)
+			}
+		})
+	}
+}
+
+func TestDecimal_ShiftPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	New(1, math.MinInt32).Shift(1)
+}
+
+func TestDecimal_SubNegAbs(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       Decimal
+		b       Decimal
+		wantSub string
+		wantNeg string
+		wantAbs string
+	}{
+		{"positive", New(150, 2), New(25, 1), "-1.00", "-1.50", "1.50"},
+		{"negative", New(-15, 1), New(-15, 1), "0.0", "1.5", "1.5"},
+		{"mixed scales", New(1, 0), New(1, 3), "0.999", "-1", "1"},
+		{"zero", New(0, 2), New(7, 0), "-7.00", "0.00", "0.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Sub(tt.b).String(); got != tt.wantSub {
+				t.Errorf("Sub() = %v, want %v", got, tt.wantSub)
+			}
+			if got := tt.a.Neg().String(); got != tt.wantNeg {
+				t.Errorf("Neg() = %v, want %v", got, tt.wantNeg)
+			}
+			if got := tt.a.Abs().String(); got != tt.wantAbs {
+				t.Errorf("Abs() = %v, want %v", got, tt.wantAbs)
+			}
+		})
+	}
+}
+
+func TestPercentDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         Decimal
+		b         Decimal
+		precision int32
+		mode      RoundingMode
+		want      string
+		wantErr   bool
+	}{
+		{"100 vs 150", New(100, 0), New(150, 0), 2, RoundHalfEven, "40.00", false},
+		{"150 vs 100", New(150, 0), New(100, 0), 2, RoundHalfEven, "40.00", false},
+		{"equal", New(5, 0), New(500, 2), 2, RoundHalfEven, "0.00", false},
+		{"one zero", New(0, 0), New(10, 0), 1, RoundHalfEven, "200.0", false},
+		{"opposite signs", New(-1, 0), New(1, 0), 0, RoundHalfEven, "200", false},
+		{"thirds", New(1, 0), New(2, 0), 4, RoundHalfUp, "66.6667", false},
+		{"thirds truncated", New(1, 0), New(2, 0), 4, RoundDown, "66.6666", false},
+		{"both zero", New(0, 0), New(0, 2), 2, RoundHalfEven, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PercentDiff(tt.a, tt.b, tt.precision, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PercentDiff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("PercentDiff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentChange(t *testing.T) {
+	tests := []struct {
+		name      string
+		from      Decimal
+		to        Decimal
+		precision int32
+		mode      RoundingMode
+		want      string
+		wantErr   bool
+	}{
+		{"increase", New(80, 0), New(90, 0), 2, RoundHalfEven, "12.50", false},
+		{"decrease", New(90, 0), New(80, 0), 2, RoundHalfEven, "-11.11", false},
+		{"decrease rounded down", New(90, 0), New(80, 0), 2, RoundFloor, "-11.12", false},
+		{"doubling", New(2550, 2), New(51, 0), 0, RoundHalfEven, "100", false},
+		{"to zero", New(125, 1), New(0, 0), 1, RoundHalfEven, "-100.0", false},
+		{"unchanged at other scale", New(5, 0), New(500, 2), 2, RoundHalfEven, "0.00", false},
+		{"negative base", New(-50, 0), New(-25, 0), 1, RoundHalfEven, "-50.0", false},
+		{"thirds", New(3, 0), New(4, 0), 4, RoundHalfUp, "33.3333", false},
+		{"from zero", New(0, 2), New(10, 0), 2, RoundHalfEven, "", true},
+		{"invalid precision", New(1, 0), New(2, 0), -1, RoundHalfEven, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PercentChange(tt.from, tt.to, tt.precision, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PercentChange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("PercentChange() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentChangeFromZeroIsDivisionByZero(t *testing.T) {
+	if _, err := PercentChange(Decimal{}, One, 2, RoundHalfEven); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("PercentChange(0, 1) error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func BenchmarkDecimal_AddEqualScale(b *testing.B) {
+	x := New(123456789, 2)
+	y := New(987654321, 2)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Add(y)
+	}
+}
+
+func BenchmarkDecimal_SubEqualScale(b *testing.B) {
+	x := New(123456789, 2)
+	y := New(987654321, 2)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Sub(y)
+	}
+}
+
+func BenchmarkDecimal_SubMixedScale(b *testing.B) {
+	x := New(123456789, 2)
+	y := New(987654321, 6)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Sub(y)
+	}
+}
+
+func BenchmarkDecimal_FMAMixedScale(b *testing.B) {
+	x := New(123456789, 2)
+	y := New(3, 0)
+	z := New(987654321, 6)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.FMA(y, z)
+	}
+}
+
+func BenchmarkDecimal_AddMixedScale(b *testing.B) {
+	x := New(123456789, 2)
+	y := New(987654321, 6)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Add(y)
+	}
+}
+
+func TestDecimal_Divide(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         Decimal
+		b         Decimal
+		precision int32
+		mode      RoundingMode
+		want      string
+		wantErr   bool
+	}{
+		{"quarter padded", New(1, 0), New(4, 0), 10, RoundHalfEven, "0.2500000000", false},
+		{"third", New(1, 0), New(3, 0), 4, RoundHalfEven, "0.3333", false},
+		{"two thirds", New(2, 0), New(3, 0), 4, RoundHalfEven, "0.6667", false},
+		{"negative", New(-2, 0), New(3, 0), 2, RoundDown, "-0.66", false},
+		{"mixed scales", New(15, 1), New(25, 2), 2, RoundHalfEven, "6.00", false},
+		{"negative scale", New(1, -3), New(8, 0), 1, RoundHalfEven, "125.0", false},
+		{"zero precision", New(7, 0), New(2, 0), 0, RoundHalfEven, "4", false},
+		{"05up retained zero", New(241, 0), New(2, 0), 0, Round05Up, "121", false},
+		{"05up retained other", New(1, 0), New(3, 0), 2, Round05Up, "0.33", false},
+		{"05up negative retained five", New(-31, 0), New(2, 0), 0, Round05Up, "-16", false},
+		{"zero divisor", New(1, 0), New(0, 3), 2, RoundHalfEven, "", true},
+		{"rounding necessary", New(1, 0), New(3, 0), 2, RoundUnnecessary, "", true},
+		{"negative precision", New(1, 0), New(3, 0), -1, RoundHalfEven, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.a.Divide(tt.b, tt.precision, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Divide() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("Divide() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_DivideTrim(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         Decimal
+		b         Decimal
+		precision int32
+		want      string
+		wantScale int32
+	}{
+		{"quarter", New(1, 0), New(4, 0), 10, "0.25", 2},
+		{"third keeps precision", New(1, 0), New(3, 0), 10, "0.3333333333", 10},
+		{"integer result", New(10, 0), New(2, 0), 10, "5", 0},
+		{"preferred scale kept", New(100, 2), New(4, 0), 10, "0.25", 2},
+		{"preferred scale pads", New(1000, 3), New(2, 0), 10, "0.500", 3},
+		{"divisor scale", New(1, 0), New(25, 2), 10, "4", 0},
+		{"negative", New(-3, 0), New(8, 0), 10, "-0.375", 3},
+		{"zero", New(0, 0), New(7, 0), 10, "0", 0},
+		{"exact at precision", New(1, 0), New(8, 0), 3, "0.125", 3},
+		{"rounded", New(1, 0), New(8, 0), 2, "0.12", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.a.DivideTrim(tt.b, tt.precision, RoundHalfEven)
+			if err != nil {
+				t.Fatalf("DivideTrim() unexpected error: %v", err)
+			}
+			if got.String() != tt.want || got.scale != tt.wantScale {
+				t.Errorf("DivideTrim() = %v scale %d, want %v scale %d", got, got.scale, tt.want, tt.wantScale)
+			}
+		})
+	}
+	if _, err := New(1, 0).DivideTrim(New(0, 0), 2, RoundHalfEven); err == nil {
+		t.Error("DivideTrim() by zero did not return an error")
+	}
+}
+
+func TestDecimal_NegIf(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Decimal
+		cond      bool
+		wantVal   string
+		wantScale int32
+	}{
+		{"flip positive", New(12500, 2), true, "-12500", 2},
+		{"flip negative", New(-12500, 2), true, "12500", 2},
+		{"keep", New(12500, 2), false, "12500", 2},
+		{"keep negative scale", New(-3, -2), false, "-3", -2},
+		{"flip zero", New(0, 3), true, "0", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.NegIf(tt.cond)
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+				t.Errorf("NegIf(%t) = %v scale %d, want %v scale %d", tt.cond, got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+			}
+		})
+	}
+}
+
+func TestDecimal_Multiply(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Decimal
+		b    Decimal
+		want string
+	}{
+		{"fractions", New(15, 1), New(25, 2), "0.375"},
+		{"negative", New(-12, 1), New(3, 0), "-3.6"},
+		{"negative scale", New(12, -2), New(5, 1), "600"},
+		{"zero", New(0, 2), New(123, 1), "0.000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Multiply(tt.b).String(); got != tt.want {
+				t.Errorf("Multiply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_MultiplyScaleOverflowPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	New(1, math.MaxInt32).Multiply(New(1, 1))
+}
+
+func TestProductCapped(t *testing.T) {
+	factors := []Decimal{New(105, 2), New(987, 3), New(10123, 4), New(5, 1), New(99999, 5), New(3, 0)}
+
+	exact := One
+	for _, f := range factors {
+		exact = exact.Multiply(f)
+	}
+
+	got, err := ProductCapped(6, RoundHalfEven, factors...)
+	if err != nil {
+		t.Fatalf("ProductCapped() unexpected error: %v", err)
+	}
+	if got.scale > 6 {
+		t.Errorf("ProductCapped() scale = %d, want at most 6", got.scale)
+	}
+	if exact.scale <= 6 {
+		t.Fatalf("exact product scale = %d, test needs it to exceed the cap", exact.scale)
+	}
+	tolerance := New(int64(len(factors)), 6)
+	if diff := got.Sub(exact).Abs(); diff.GreaterThan(tolerance) {
+		t.Errorf("ProductCapped() = %v, exact %v differs by %v, want within %v", got, exact, diff, tolerance)
+	}
+}
+
+func TestProductCappedEdgeCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxScale int32
+		mode     RoundingMode
+		values   []Decimal
+		want     string
+		wantErr  bool
+	}{
+		{"empty product", 2, RoundHalfEven, nil, "1", false},
+		{"below cap stays exact", 4, RoundHalfEven, []Decimal{New(15, 1), New(15, 1)}, "2.25", false},
+		{"rounded at cap", 1, RoundHalfUp, []Decimal{New(15, 1), New(15, 1)}, "2.3", false},
+		{"rounded at cap half even", 1, RoundHalfEven, []Decimal{New(15, 1), New(15, 1)}, "2.2", false},
+		{"scale overflow", math.MaxInt32, RoundHalfEven, []Decimal{New(1, math.MaxInt32), New(1, 1)}, "", true},
+		{"negative scale overflow", 2, RoundHalfEven, []Decimal{New(1, math.MinInt32), New(1, -1)}, "", true},
+		{"far below cap rounds to zero", 2, RoundHalfEven, []Decimal{New(1, math.MaxInt32)}, "0.00", false},
+		{"far below cap rounds up", 2, RoundUp, []Decimal{New(1, math.MaxInt32)}, "0.01", false},
+		{"far below cap negative ceiling", 2, RoundCeiling, []Decimal{New(-123, math.MaxInt32)}, "0.00", false},
+		{"far below cap negative floor", 2, RoundFloor, []Decimal{New(-123, math.MaxInt32)}, "-0.01", false},
+		{"unnecessary exact", 2, RoundUnnecessary, []Decimal{New(15, 1), New(2, 0)}, "3.0", false},
+		{"unnecessary trailing zeros", 1, RoundUnnecessary, []Decimal{New(150, 2), New(20, 1)}, "3.0", false},
+		{"unnecessary inexact", 1, RoundUnnecessary, []Decimal{New(15, 1), New(15, 1)}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProductCapped(tt.maxScale, tt.mode, tt.values...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ProductCapped() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("ProductCapped() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_ZeroValueArithmetic(t *testing.T) {
+	var zero Decimal
+	five := New(5, 0)
+	quo, err := zero.Divide(five, 2, RoundHalfEven)
+	if err != nil {
+		t.Fatalf("Divide() unexpected error: %v", err)
+	}
+	if _, err := five.Divide(zero, 2, RoundHalfEven); err == nil {
+		t.Errorf("Divide() by the zero value did not return an error")
+	}
+	tests := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"zero plus five", zero.Add(five), "5"},
+		{"five plus zero", five.Add(zero), "5"},
+		{"zero minus five", zero.Sub(five), "-5"},
+		{"five minus zero", five.Sub(zero), "5"},
+		{"zero times five", zero.Multiply(five), "0"},
+		{"five times zero", five.Multiply(zero), "0"},
+		{"zero over five", quo, "0.00"},
+		{"neg", zero.Neg(), "0"},
+		{"abs", zero.Abs(), "0"},
+		{"shift", zero.Shift(-2), "0.00"},
+		{"both zero", zero.Add(Decimal{}), "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_FMA(t *testing.T) {
+	tests := []struct {
+		name      string
+		d         Decimal
+		mul       Decimal
+		add       Decimal
+		want      string
+		wantScale int32
+	}{
+		{"integers", New(3, 0), New(4, 0), New(5, 0), "17", 0},
+		{"product scale wins", New(15, 1), New(25, 2), New(1, 0), "1.375", 3},
+		{"addend scale wins", New(2, 0), New(3, 0), New(1, 4), "6.0001", 4},
+		{"negative addend", New(12, 1), New(-5, 0), New(6, 0), "0.0", 1},
+		{"negative scales", New(3, -2), New(2, -1), New(7, 0), "6007", 0},
+		{"zero product", New(0, 3), New(123, 1), New(-45, 2), "-0.4500", 4},
+		{"zero value", Decimal{}, New(5, 0), New(1, 1), "0.1", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.d.FMA(tt.mul, tt.add)
+			if got.String() != tt.want || got.scale != tt.wantScale {
+				t.Errorf("FMA() = %v scale %d, want %v scale %d", got, got.scale, tt.want, tt.wantScale)
+			}
+			if want := tt.d.Multiply(tt.mul).Add(tt.add); !got.StrictEqual(want) {
+				t.Errorf("FMA() = %v scale %d, want Multiply().Add() = %v scale %d", got, got.scale, want, want.scale)
+			}
+		})
+	}
+}
+
+func TestDecimal_FMAPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	New(1, math.MaxInt32).FMA(New(1, 1), Zero)
+}
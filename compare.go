@@ -0,0 +1,238 @@
+package decimal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+)
+
+// Cmp compares d and other numerically and returns:
+//
+//	-1 if d <  other
+//	 0 if d == other
+//	+1 if d >  other
+//
+// The comparison is scale-aware, so 1.00 and 1 compare as equal.
+// Neither receiver nor argument is modified.
+func (d Decimal) Cmp(other Decimal) int {
+	d, other = d.orZero(), other.orZero()
+	// Fast path: identical scales compare the unscaled values directly
+	if d.scale == other.scale {
+		return d.unscaledValue.Cmp(other.unscaledValue)
+	}
+
+	// Differing signs decide the result without aligning scales
+	if ds, os := d.unscaledValue.Sign(), other.unscaledValue.Sign(); ds != os {
+		if ds < os {
+			return -1
+		}
+		return 1
+	}
+
+	// Aligning far-apart scales would build a huge power of ten
+	if gap := int64(d.scale) - int64(other.scale); gap > cmpRescaleLimit || gap < -cmpRescaleLimit {
+		return d.CmpRat(other)
+	}
+
+	x, y, scratch, _ := alignCoefficients(d, other)
+	defer putScratch(scratch)
+	return x.Cmp(y)
+}
+
+// cmpRescaleLimit is the largest scale gap Cmp bridges by rescaling; beyond
+// it Cmp compares through CmpRat.
+const cmpRescaleLimit = 64
+
+// CmpRat compares d and other exactly, like Cmp, without building an
+// intermediate whose size depends on how far apart the scales are, so
+// comparing New(1, -1000000) with New(1, 1000000) is immediate. Values of
+// different sign, or whose orders of magnitude differ, are decided without
+// any arithmetic; otherwise the scales can differ by at most the number of
+// digits in the coefficients, and aligning them is cheap. Cmp uses it when
+// the scale gap is large.
+func (d Decimal) CmpRat(other Decimal) int {
+	d, other = d.orZero(), other.orZero()
+	ds, os := d.unscaledValue.Sign(), other.unscaledValue.Sign()
+	switch {
+	case ds != os && ds < os:
+		return -1
+	case ds != os:
+		return 1
+	case ds == 0:
+		return 0
+	}
+
+	// The number of integer digits, which may be negative, orders values
+	// of the same sign by magnitude
+	dMag := int64(numDigits(d.unscaledValue)) - int64(d.scale)
+	oMag := int64(numDigits(other.unscaledValue)) - int64(other.scale)
+	if dMag != oMag {
+		if (dMag > oMag) == (ds > 0) {
+			return 1
+		}
+		return -1
+	}
+
+	scale := max(d.scale, other.scale)
+	return d.rescale(scale).unscaledValue.Cmp(other.rescale(scale).unscaledValue)
+}
+
+// GreaterThan reports whether d > other.
+func (d Decimal) GreaterThan(other Decimal) bool {
+	return d.Cmp(other) > 0
+}
+
+// GreaterThanOrEqual reports whether d >= other.
+func (d Decimal) GreaterThanOrEqual(other Decimal) bool {
+	return d.Cmp(other) >= 0
+}
+
+// LessThan reports whether d < other.
+func (d Decimal) LessThan(other Decimal) bool {
+	return d.Cmp(other) < 0
+}
+
+// LessThanOrEqual reports whether d <= other.
+func (d Decimal) LessThanOrEqual(other Decimal) bool {
+	return d.Cmp(other) <= 0
+}
+
+// Equal reports whether d and other represent the same number, regardless
+// of scale: 1.5 equals 1.50. Use Equal for business logic and numeric tests.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// StrictEqual reports whether d and other have the same value and the same
+// scale: 1.5 does not strictly equal 1.50. Use StrictEqual when the
+// representation matters, e.g. in tests asserting an exact output scale or
+// when deriving map keys from the raw coefficient and scale.
+func (d Decimal) StrictEqual(other Decimal) bool {
+	d, other = d.orZero(), other.orZero()
+	return d.scale == other.scale && d.unscaledValue.Cmp(other.unscaledValue) == 0
+}
+
+// Sign returns -1, 0 or +1 depending on whether d is negative, zero or
+// positive. The scale never affects the sign. A zero-value Decimal is
+// treated as zero.
+func (d Decimal) Sign() int {
+	if d.unscaledValue == nil {
+		return 0
+	}
+	return d.unscaledValue.Sign()
+}
+
+// IsZero reports whether d is zero at any scale, e.g. 0 or 0.00000.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// IsPositive reports whether d is strictly greater than zero.
+func (d Decimal) IsPositive() bool {
+	return d.Sign() > 0
+}
+
+// IsNegative reports whether d is strictly less than zero.
+func (d Decimal) IsNegative() bool {
+	return d.Sign() < 0
+}
+
+// Hash returns a hash of the numeric value of d, computed over its Normalize
+// form so that numerically equal Decimals such as 1.5 and 1.50 hash
+// identically. Together with Equal it lets callers build hash sets and maps
+// keyed by value; unequal Decimals may collide.
+func (d Decimal) Hash() uint64 {
+	n := d.Normalize()
+	h := fnv.New64a()
+	var header [5]byte
+	header[0] = byte(n.unscaledValue.Sign() + 1)
+	binary.LittleEndian.PutUint32(header[1:], uint32(n.scale))
+	h.Write(header[:])
+	h.Write(n.unscaledValue.Bytes())
+	return h.Sum64()
+}
+
+// EqualAtScale reports whether d and other are equal once both are truncated
+// toward zero to scale fractional digits, matching rules such as "equal to
+// the cent": 1.234 and 1.239 are equal at scale 2 but not at scale 3.
+// Values that already fit the scale are compared with Cmp. Otherwise the two
+// coefficients are brought to scale directly, using at most one temporary
+// big.Int per operand and no intermediate Decimal.
+func (d Decimal) EqualAtScale(other Decimal, scale int32) bool {
+	if d.scale <= scale && other.scale <= scale {
+		return d.Cmp(other) == 0
+	}
+	return d.coefficientAt(scale).Cmp(other.coefficientAt(scale)) == 0
+}
+
+// coefficientAt returns the coefficient of d truncated toward zero, or padded
+// with zeros, to scale fractional digits. The result may alias d's
+// coefficient and must not be modified.
+func (d Decimal) coefficientAt(scale int32) *big.Int {
+	d = d.orZero()
+	switch {
+	case d.scale > scale:
+		return new(big.Int).Quo(d.unscaledValue, pow10(d.scale-scale))
+	case d.scale < scale:
+		return new(big.Int).Mul(d.unscaledValue, pow10(scale-d.scale))
+	default:
+		return d.unscaledValue
+	}
+}
+
+// NearestIn returns the element of allowed closest to d by absolute
+// difference, for snapping arbitrary prices onto a tick ladder. When d lies
+// exactly halfway between two elements the lower one wins. allowed does not
+// need to be sorted. It returns an error if allowed is empty.
+func (d Decimal) NearestIn(allowed []Decimal) (Decimal, error) {
+	if len(allowed) == 0 {
+		return Decimal{}, fmt.Errorf("cannot find nearest value in an empty set")
+	}
+
+	best := allowed[0]
+	bestDistance := d.Sub(best).Abs()
+	for _, candidate := range allowed[1:] {
+		distance := d.Sub(candidate).Abs()
+		switch distance.Cmp(bestDistance) {
+		case -1:
+			best, bestDistance = candidate, distance
+		case 0:
+			if candidate.LessThan(best) {
+				best = candidate
+			}
+		}
+	}
+	return best, nil
+}
+
+// Clamp returns lower if d < lower, upper if d > upper and d otherwise,
+// comparing numerically so the bounds may have any scale. The result is one
+// of the three arguments, unchanged. It panics if lower > upper, which is a
+// programming error rather than a data condition.
+func (d Decimal) Clamp(lower, upper Decimal) Decimal {
+	if lower.Cmp(upper) > 0 {
+		panic(fmt.Sprintf("Clamp: lower bound %s is greater than upper bound %s", lower, upper))
+	}
+	if d.Cmp(lower) < 0 {
+		return lower
+	}
+	if d.Cmp(upper) > 0 {
+		return upper
+	}
+	return d
+}
+
+// InRange reports whether d lies between lower and upper, comparing
+// numerically so the bounds may have any scale. With inclusive true the
+// bounds themselves are in range, as in "price must be between 0.01 and
+// 9999.99"; with inclusive false only values strictly between them are.
+// Unlike Clamp it does not panic when lower > upper: such an empty range
+// contains nothing, and InRange returns false.
+func (d Decimal) InRange(lower, upper Decimal, inclusive bool) bool {
+	lo, hi := d.Cmp(lower), d.Cmp(upper)
+	if inclusive {
+		return lo >= 0 && hi <= 0
+	}
+	return lo > 0 && hi < 0
+}
@@ -0,0 +1,394 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDecimal_Cmp(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Decimal
+		b    Decimal
+		want int
+	}{
+		{"equal same scale", New(123, 2), New(123, 2), 0},
+		{"less same scale", New(122, 2), New(123, 2), -1},
+		{"greater same scale", New(124, 2), New(123, 2), 1},
+		{"zero different scales", New(0, 2), New(0, 0), 0},
+		{"1.00 vs 1", New(100, 2), New(1, 0), 0},
+		{"1.5 vs 1.50", New(15, 1), New(150, 2), 0},
+		{"1.5 vs 1.49", New(15, 1), New(149, 2), 1},
+		{"negative vs positive", New(-1, 3), New(1, 0), -1},
+		{"positive vs negative", New(1, 3), New(-1000, 0), 1},
+		{"negative mixed scales", New(-15, 1), New(-149, 2), -1},
+		{"negative scale equal", New(1, -2), New(100, 0), 0},
+		{"negative scale greater", New(2, -2), New(199, 0), 1},
+		{"negative scale vs fraction", New(1, -1), New(1001, 2), -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Cmp(tt.b); got != tt.want {
+				t.Errorf("%v.Cmp(%v) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := tt.b.Cmp(tt.a); got != -tt.want {
+				t.Errorf("%v.Cmp(%v) = %d, want %d", tt.b, tt.a, got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_CmpRat(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Decimal
+		b    Decimal
+		want int
+	}{
+		{"huge vs tiny", New(1, -1000000), New(1, 1000000), 1},
+		{"negative huge vs negative tiny", New(-1, -1000000), New(-1, 1000000), -1},
+		{"tiny vs zero", New(1, 1000000), New(0, -1000000), 1},
+		{"zero vs negative tiny", New(0, 0), New(-1, math.MaxInt32), 1},
+		{"zeros far apart", New(0, math.MinInt32), New(0, math.MaxInt32), 0},
+		{"equal far apart", New(1, -100), Decimal{unscaledValue: pow10(200), scale: 100}, 0},
+		{"same magnitude", New(15, 1), New(149, 2), 1},
+		{"same magnitude negative", New(-15, 1), New(-149, 2), -1},
+		{"equal", New(100, 2), New(1, 0), 0},
+		{"extreme scales", New(9, math.MaxInt32), New(1, math.MinInt32), -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.CmpRat(tt.b); got != tt.want {
+				t.Errorf("CmpRat() = %d, want %d", got, tt.want)
+			}
+			if got := tt.b.CmpRat(tt.a); got != -tt.want {
+				t.Errorf("reversed CmpRat() = %d, want %d", got, -tt.want)
+			}
+			if got := tt.a.Cmp(tt.b); got != tt.want {
+				t.Errorf("Cmp() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_CmpDoesNotMutate(t *testing.T) {
+	a := New(15, 1)
+	b := New(150, 2)
+	a.Cmp(b)
+	if a.unscaledValue.String() != "15" || a.scale != 1 {
+		t.Errorf("Cmp mutated receiver: got %v scale %d", a.unscaledValue, a.scale)
+	}
+	if b.unscaledValue.String() != "150" || b.scale != 2 {
+		t.Errorf("Cmp mutated argument: got %v scale %d", b.unscaledValue, b.scale)
+	}
+}
+
+func BenchmarkDecimal_CmpEqualScale(b *testing.B) {
+	x := New(123456789, 4)
+	y := New(123456790, 4)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Cmp(y)
+	}
+}
+
+func BenchmarkDecimal_CmpMixedScale(b *testing.B) {
+	x := New(123456789, 4)
+	y := New(1234567890, 5)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Cmp(y)
+	}
+}
+
+func TestDecimal_EqualStrictEqual(t *testing.T) {
+	tests := []struct {
+		name       string
+		a          Decimal
+		b          Decimal
+		wantEqual  bool
+		wantStrict bool
+	}{
+		{"1.50 vs 1.5", New(150, 2), New(15, 1), true, false},
+		{"1.5 vs 1.5", New(15, 1), New(15, 1), true, true},
+		{"1.5 vs 1.51", New(15, 1), New(151, 2), false, false},
+		{"zero scales", New(0, 3), New(0, 0), true, false},
+		{"negative", New(-150, 2), New(-15, 1), true, false},
+		{"sign differs", New(15, 1), New(-15, 1), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.wantEqual {
+				t.Errorf("Equal() = %t, want %t", got, tt.wantEqual)
+			}
+			if got := tt.a.StrictEqual(tt.b); got != tt.wantStrict {
+				t.Errorf("StrictEqual() = %t, want %t", got, tt.wantStrict)
+			}
+		})
+	}
+}
+
+func TestDecimal_SignPredicates(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        Decimal
+		wantSign     int
+		wantZero     bool
+		wantPositive bool
+		wantNegative bool
+	}{
+		{"zero", New(0, 0), 0, true, false, false},
+		{"zero with scale", New(0, 5), 0, true, false, false},
+		{"zero value", Decimal{}, 0, true, false, false},
+		{"positive", New(1, 3), 1, false, true, false},
+		{"negative", New(-1, 3), -1, false, false, true},
+		{"negative scale", New(-5, -2), -1, false, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Sign(); got != tt.wantSign {
+				t.Errorf("Sign() = %d, want %d", got, tt.wantSign)
+			}
+			if got := tt.input.IsZero(); got != tt.wantZero {
+				t.Errorf("IsZero() = %t, want %t", got, tt.wantZero)
+			}
+			if got := tt.input.IsPositive(); got != tt.wantPositive {
+				t.Errorf("IsPositive() = %t, want %t", got, tt.wantPositive)
+			}
+			if got := tt.input.IsNegative(); got != tt.wantNegative {
+				t.Errorf("IsNegative() = %t, want %t", got, tt.wantNegative)
+			}
+		})
+	}
+}
+
+func TestDecimal_ComparisonOperators(t *testing.T) {
+	tests := []struct {
+		name   string
+		a      Decimal
+		b      Decimal
+		wantGT bool
+		wantGE bool
+		wantLT bool
+		wantLE bool
+	}{
+		{"1.0 < 1.5", New(10, 1), New(15, 1), false, false, true, true},
+		{"2.00 == 2", New(200, 2), New(2, 0), false, true, false, true},
+		{"1.51 > 1.5", New(151, 2), New(15, 1), true, true, false, false},
+		{"-1.5 < -1.49", New(-15, 1), New(-149, 2), false, false, true, true},
+		{"-1 > -10.0", New(-1, 0), New(-100, 1), true, true, false, false},
+		{"-0.5 < 0", New(-5, 1), New(0, 3), false, false, true, true},
+		{"1e2 > 99.99", New(1, -2), New(9999, 2), true, true, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.GreaterThan(tt.b); got != tt.wantGT {
+				t.Errorf("GreaterThan() = %t, want %t", got, tt.wantGT)
+			}
+			if got := tt.a.GreaterThanOrEqual(tt.b); got != tt.wantGE {
+				t.Errorf("GreaterThanOrEqual() = %t, want %t", got, tt.wantGE)
+			}
+			if got := tt.a.LessThan(tt.b); got != tt.wantLT {
+				t.Errorf("LessThan() = %t, want %t", got, tt.wantLT)
+			}
+			if got := tt.a.LessThanOrEqual(tt.b); got != tt.wantLE {
+				t.Errorf("LessThanOrEqual() = %t, want %t", got, tt.wantLE)
+			}
+		})
+	}
+}
+
+func TestDecimal_Hash(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     Decimal
+		b     Decimal
+		equal bool
+	}{
+		{"1.5 vs 1.50", New(15, 1), New(150, 2), true},
+		{"1500 vs 15e2", New(1500, 0), New(15, -2), true},
+		{"zeros", New(0, 3), New(0, -1), true},
+		{"-2 vs -2.000", New(-2, 0), New(-2000, 3), true},
+		{"sign differs", New(15, 1), New(-15, 1), false},
+		{"value differs", New(15, 1), New(16, 1), false},
+		{"scale differs", New(15, 1), New(15, 2), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Hash() == tt.b.Hash(); got != tt.equal {
+				t.Errorf("Hash() equal = %t, want %t", got, tt.equal)
+			}
+			if tt.a.Equal(tt.b) != tt.equal {
+				t.Errorf("Equal() = %t, want %t", !tt.equal, tt.equal)
+			}
+		})
+	}
+}
+
+func TestDecimal_HashSet(t *testing.T) {
+	buckets := make(map[uint64][]Decimal)
+	for _, d := range []Decimal{New(15, 1), New(150, 2), New(2, 0), New(1500, 3), New(200, 2)} {
+		buckets[d.Hash()] = append(buckets[d.Hash()], d)
+	}
+	if len(buckets) != 2 {
+		t.Errorf("got %d distinct hashes, want 2", len(buckets))
+	}
+}
+
+func TestDecimal_EqualAtScale(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     Decimal
+		b     Decimal
+		scale int32
+		want  bool
+	}{
+		{"1.234 vs 1.239 at 2", New(1234, 3), New(1239, 3), 2, true},
+		{"1.234 vs 1.239 at 3", New(1234, 3), New(1239, 3), 3, false},
+		{"1.239 vs 1.24 at 2", New(1239, 3), New(124, 2), 2, false},
+		{"negative truncates toward zero", New(-1239, 3), New(-123, 2), 2, true},
+		{"sign differs", New(-1, 3), New(1, 3), 2, true},
+		{"already within scale", New(15, 1), New(150, 2), 2, true},
+		{"integer scale", New(19, 1), New(1, 0), 0, true},
+		{"one operand below scale", New(12, 1), New(1209, 3), 2, true},
+		{"one operand below scale differs", New(12, 1), New(1219, 3), 2, false},
+		{"negative target scale", New(1299, 0), New(1201, 0), -2, true},
+		{"negative operand scale", New(12, -2), New(1299, 0), -2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.EqualAtScale(tt.b, tt.scale); got != tt.want {
+				t.Errorf("EqualAtScale(%d) = %t, want %t", tt.scale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_NearestIn(t *testing.T) {
+	ladder := []Decimal{New(100, 2), New(105, 2), New(110, 2), New(120, 2)}
+	tests := []struct {
+		name    string
+		input   Decimal
+		allowed []Decimal
+		want    string
+		wantErr bool
+	}{
+		{"between closer to upper", New(1042, 3), ladder, "1.05", false},
+		{"between closer to lower", New(1012, 3), ladder, "1.00", false},
+		{"exact match", New(11, 1), ladder, "1.10", false},
+		{"tie goes lower", New(1025, 3), ladder, "1.00", false},
+		{"tie goes lower unsorted", New(115, 2), []Decimal{New(120, 2), New(110, 2)}, "1.10", false},
+		{"below ladder", New(-5, 0), ladder, "1.00", false},
+		{"above ladder", New(9, 0), ladder, "1.20", false},
+		{"negative values", New(-15, 1), []Decimal{New(-1, 0), New(-2, 0)}, "-2", false},
+		{"empty", New(1, 0), nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.input.NearestIn(tt.allowed)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NearestIn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NearestIn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Clamp(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Decimal
+		lower Decimal
+		upper Decimal
+		want  string
+	}{
+		{"below", New(5, 1), New(100, 2), New(10, 0), "1.00"},
+		{"above", New(1234, 2), New(1, 0), New(100, 1), "10.0"},
+		{"inside", New(55, 1), New(1, 0), New(10, 0), "5.5"},
+		{"equal lower keeps input", New(1000, 3), New(1, 0), New(10, 0), "1.000"},
+		{"equal upper keeps input", New(10, 0), New(1, 0), New(1000, 2), "10"},
+		{"negative", New(-3, 0), New(-25, 1), New(25, 1), "-2.5"},
+		{"degenerate range", New(7, 0), New(2, 0), New(20, 1), "2.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Clamp(tt.lower, tt.upper); got.String() != tt.want {
+				t.Errorf("Clamp(%v, %v) = %v, want %v", tt.lower, tt.upper, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_ClampPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	New(1, 0).Clamp(New(20, 1), New(19, 1))
+}
+
+func TestDecimal_InRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         Decimal
+		lower         Decimal
+		upper         Decimal
+		wantInclusive bool
+		wantExclusive bool
+	}{
+		{"inside", New(500, 2), New(1, 2), New(999999, 2), true, true},
+		{"lower bound", New(1, 2), New(1, 2), New(999999, 2), true, false},
+		{"upper bound", New(999999, 2), New(1, 2), New(999999, 2), true, false},
+		{"lower bound at other scale", New(10, 3), New(1, 2), New(999999, 2), true, false},
+		{"upper bound at other scale", New(9999990, 3), New(1, 2), New(999999, 2), true, false},
+		{"just below", New(9, 3), New(1, 2), New(999999, 2), false, false},
+		{"just above", New(9999991, 3), New(1, 2), New(999999, 2), false, false},
+		{"zero", New(0, 0), New(1, 2), New(999999, 2), false, false},
+		{"negative range", New(-15, 1), New(-2, 0), New(-1, 0), true, true},
+		{"single point", New(20, 1), New(2, 0), New(200, 2), true, false},
+		{"lower above upper", New(15, 1), New(2, 0), New(1, 0), false, false},
+		{"lower above upper at bound", New(2, 0), New(2, 0), New(1, 0), false, false},
+		{"zero value", Decimal{}, New(0, 2), New(1, 0), true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.InRange(tt.lower, tt.upper, true); got != tt.wantInclusive {
+				t.Errorf("InRange(%v, %v, true) = %t, want %t", tt.lower, tt.upper, got, tt.wantInclusive)
+			}
+			if got := tt.input.InRange(tt.lower, tt.upper, false); got != tt.wantExclusive {
+				t.Errorf("InRange(%v, %v, false) = %t, want %t", tt.lower, tt.upper, got, tt.wantExclusive)
+			}
+		})
+	}
+}
+
+func TestDecimal_ZeroValueCompare(t *testing.T) {
+	var zero Decimal
+	if got := zero.Cmp(New(0, 3)); got != 0 {
+		t.Errorf("Cmp(0.000) = %d, want 0", got)
+	}
+	if got := zero.Cmp(New(1, 2)); got != -1 {
+		t.Errorf("Cmp(0.01) = %d, want -1", got)
+	}
+	if got := New(-1, 100).Cmp(zero); got != -1 {
+		t.Errorf("-1e-100 Cmp(zero value) = %d, want -1", got)
+	}
+	if got := zero.CmpRat(New(1, -100)); got != -1 {
+		t.Errorf("CmpRat(1e100) = %d, want -1", got)
+	}
+	if !zero.Equal(Zero) || !zero.StrictEqual(New(0, 0)) {
+		t.Errorf("zero value is not equal to 0")
+	}
+	if !zero.LessThan(New(5, 0)) || !zero.GreaterThan(New(-5, 0)) {
+		t.Errorf("zero value does not order as 0")
+	}
+	if zero.Hash() != Zero.Hash() {
+		t.Errorf("Hash() of the zero value differs from Hash() of 0")
+	}
+	if got := zero.Clamp(New(1, 0), New(2, 0)); got.String() != "1" {
+		t.Errorf("Clamp(1, 2) = %v, want 1", got)
+	}
+}
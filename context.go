@@ -0,0 +1,35 @@
+package decimal
+
+// Context bundles the precision and rounding mode used throughout a
+// calculation, in the spirit of Python's decimal.Context, so that policy is
+// chosen once and threaded through a service instead of being repeated at
+// every call. Precision is a number of fractional digits, as for Divide and
+// Round.
+type Context struct {
+	Precision int32
+	Rounding  RoundingMode
+}
+
+// DefaultContext uses 16 fractional digits and banker's rounding.
+var DefaultContext = Context{Precision: 16, Rounding: RoundHalfEven}
+
+// Round returns d rounded to c.Precision fractional digits with c.Rounding.
+func (c Context) Round(d Decimal) Decimal {
+	return d.Round(c.Precision, c.Rounding)
+}
+
+// Multiply returns a * b rounded to c.Precision fractional digits. Products
+// that already fit the precision keep their exact scale.
+func (c Context) Multiply(a, b Decimal) Decimal {
+	product := a.Multiply(b)
+	if product.scale > c.Precision {
+		return c.Round(product)
+	}
+	return product
+}
+
+// Divide returns a / b rounded to c.Precision fractional digits with
+// c.Rounding, with the errors of Decimal.Divide.
+func (c Context) Divide(a, b Decimal) (Decimal, error) {
+	return a.Divide(b, c.Precision, c.Rounding)
+}
@@ -0,0 +1,56 @@
+package decimal
+
+import (
+	"testing"
+)
+
+func TestContext_Divide(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     Context
+		a       Decimal
+		b       Decimal
+		want    string
+		wantErr bool
+	}{
+		{"default third", DefaultContext, New(1, 0), New(3, 0), "0.3333333333333333", false},
+		{"default two thirds", DefaultContext, New(2, 0), New(3, 0), "0.6666666666666667", false},
+		{"cents down", Context{2, RoundDown}, New(2, 0), New(3, 0), "0.66", false},
+		{"cents up", Context{2, RoundUp}, New(1, 0), New(3, 0), "0.34", false},
+		{"zero divisor", DefaultContext, New(1, 0), New(0, 0), "", true},
+		{"unnecessary", Context{2, RoundUnnecessary}, New(1, 0), New(3, 0), "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.ctx.Divide(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Divide() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("Divide() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContext_RoundMultiply(t *testing.T) {
+	cents := Context{Precision: 2, Rounding: RoundHalfUp}
+	tests := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"round", cents.Round(New(12345, 3)), "12.35"},
+		{"round pads", cents.Round(New(5, 1)), "0.50"},
+		{"multiply rounds", cents.Multiply(New(1999, 2), New(75, 3)), "1.50"},
+		{"multiply keeps exact scale", cents.Multiply(New(15, 1), New(3, 0)), "4.5"},
+		{"default round", DefaultContext.Round(New(1, 0)), "1.0000000000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
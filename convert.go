@@ -0,0 +1,207 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// Coefficient returns a copy of the unscaled value of d, so that
+// d == Coefficient * 10^-Scale. Modifying the result does not affect d.
+func (d Decimal) Coefficient() *big.Int {
+	d = d.orZero()
+	return new(big.Int).Set(d.unscaledValue)
+}
+
+// Scale returns the number of fractional digits d is represented with. It is
+// negative for values such as 1.2e+5 whose coefficient is multiplied by a
+// power of ten.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Exponent returns the power of ten applied to the coefficient, following the
+// IEEE 754 and General Decimal Arithmetic convention: it is -Scale, so 1.23
+// has coefficient 123 and exponent -2.
+func (d Decimal) Exponent() int32 {
+	return -d.scale
+}
+
+// Rat returns the exact value of d, coefficient / 10^scale, as a new big.Rat.
+// The result is independent of d and may be modified freely.
+func (d Decimal) Rat() *big.Rat {
+	d = d.orZero()
+	if d.scale < 0 {
+		return new(big.Rat).SetInt(new(big.Int).Mul(d.unscaledValue, pow10(-d.scale)))
+	}
+	return new(big.Rat).SetFrac(d.unscaledValue, pow10(d.scale))
+}
+
+// BigInt returns the integer part of d, truncated toward zero, as a new
+// big.Int: 1.99 gives 1 and -1.99 gives -1. The result is independent of d
+// and may be modified freely.
+func (d Decimal) BigInt() *big.Int {
+	d = d.orZero()
+	switch {
+	case d.scale < 0:
+		return new(big.Int).Mul(d.unscaledValue, pow10(-d.scale))
+	case d.scale > 0:
+		return new(big.Int).Quo(d.unscaledValue, pow10(d.scale))
+	default:
+		return new(big.Int).Set(d.unscaledValue)
+	}
+}
+
+// Float64 returns the float64 nearest to d and reports whether it represents
+// d exactly. Values such as 0.5 and 0.25 convert exactly, while 0.1 cannot
+// be represented in binary and reports exact == false. Values too large for
+// a float64 return ±Inf with exact == false.
+func (d Decimal) Float64() (f float64, exact bool) {
+	return d.Rat().Float64()
+}
+
+// InexactFloat64 returns the float64 nearest to d, like Float64 but without
+// the exactness flag, for callers such as charts that accept the loss. Values
+// too large for a float64 return ±Inf.
+func (d Decimal) InexactFloat64() float64 {
+	f, _ := d.Float64()
+	return f
+}
+
+// BigFloat returns d as a new big.Float with a mantissa of prec bits. The
+// quotient coefficient / 10^scale is rounded once, with big.ToNearestEven,
+// so terminating binary fractions such as 2.5 are exact. Other values like
+// 0.1 are the nearest prec-bit approximation, and the result's Acc reports
+// the direction. A prec of 0 lets big.Float choose the larger of 64 and the bit
+// lengths of the coefficient and 10^scale.
+func (d Decimal) BigFloat(prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec).SetRat(d.orZero().Rat())
+}
+
+// integerValue returns the value of d as an integer, materializing negative
+// scales, or an error if d has a non-zero fractional part.
+func (d Decimal) integerValue() (*big.Int, error) {
+	d = d.orZero()
+	switch {
+	case d.scale < 0:
+		return new(big.Int).Mul(d.unscaledValue, pow10(-d.scale)), nil
+	case d.scale > 0:
+		quo, rem := new(big.Int).QuoRem(d.unscaledValue, pow10(d.scale), new(big.Int))
+		if rem.Sign() != 0 {
+			return nil, fmt.Errorf("%s has a fractional part", d)
+		}
+		return quo, nil
+	default:
+		return d.unscaledValue, nil
+	}
+}
+
+// Int64 returns d as an int64. It returns an error if d has a non-zero
+// fractional part or lies outside the int64 range; trailing fractional zeros
+// such as in 12.00 are accepted.
+func (d Decimal) Int64() (int64, error) {
+	value, err := d.integerValue()
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert to int64: %w", err)
+	}
+	if !value.IsInt64() {
+		return 0, fmt.Errorf("cannot convert to int64: %s is out of range", d)
+	}
+	return value.Int64(), nil
+}
+
+// Uint64 returns d as a uint64. It returns an error if d is negative, has a
+// non-zero fractional part or exceeds the uint64 range.
+func (d Decimal) Uint64() (uint64, error) {
+	value, err := d.integerValue()
+	if err != nil {
+		return 0, fmt.Errorf("cannot convert to uint64: %w", err)
+	}
+	if value.Sign() < 0 {
+		return 0, fmt.Errorf("cannot convert to uint64: %s is negative", d)
+	}
+	if !value.IsUint64() {
+		return 0, fmt.Errorf("cannot convert to uint64: %s is out of range", d)
+	}
+	return value.Uint64(), nil
+}
+
+// FitsSignedBits reports whether d can be stored exactly in a signed Qm.n
+// fixed-point format, where m is integerBits and n is fractionBits. Following
+// the usual Q notation, the sign bit is not counted in m, so the format holds
+// m+n+1 bits and covers [-2^m, 2^m - 2^-n] in steps of 2^-n. Values that would
+// overflow the range or lose fractional precision report false.
+func (d Decimal) FitsSignedBits(integerBits, fractionBits uint) bool {
+	d = d.orZero()
+	// Express d in units of 2^-n; it must be an integer number of units
+	units := new(big.Int).Lsh(d.unscaledValue, fractionBits)
+	if d.scale > 0 {
+		rem := new(big.Int)
+		units.QuoRem(units, pow10(d.scale), rem)
+		if rem.Sign() != 0 {
+			return false
+		}
+	} else if d.scale < 0 {
+		units.Mul(units, pow10(-d.scale))
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), integerBits+fractionBits)
+	if units.Sign() < 0 {
+		return units.CmpAbs(limit) <= 0
+	}
+	return units.Cmp(limit) < 0
+}
+
+// ToBasisPoints returns d expressed in basis points, i.e. d * 10000, so that
+// 0.0125 becomes 125. Only the scale is adjusted; the coefficient is shared.
+func (d Decimal) ToBasisPoints() Decimal {
+	return d.Shift(4)
+}
+
+// FromBasisPoints converts an amount in basis points back to a plain ratio,
+// i.e. bp / 10000, so that 125 becomes 0.0125. It is the exact inverse of
+// ToBasisPoints.
+func FromBasisPoints(bp Decimal) Decimal {
+	return bp.Shift(-4)
+}
+
+// numDigits returns the number of decimal digits in x, ignoring the sign.
+// Zero has one digit.
+func numDigits(x *big.Int) int {
+	n := len(x.Text(10))
+	if x.Sign() < 0 {
+		n--
+	}
+	return n
+}
+
+// NumDigits returns the number of digits in the coefficient of d, i.e. its
+// precision in the NUMERIC(p,s) sense, ignoring the sign. Zero has one digit.
+// Trailing zeros count: 1.500 has 4 digits, 1.5 has 2.
+func (d Decimal) NumDigits() int {
+	d = d.orZero()
+	return numDigits(d.unscaledValue)
+}
+
+// StorageSize estimates the memory d's value occupies, in bytes: the machine
+// words of the coefficient's big.Int plus 4 bytes for the int32 scale. It
+// ignores the fixed struct and slice headers, which are the same for every
+// Decimal, so zero reports the minimum of 4.
+func (d Decimal) StorageSize() int {
+	d = d.orZero()
+	return len(d.unscaledValue.Bits())*(bits.UintSize/8) + 4
+}
+
+// OrderOfMagnitude returns the exponent k such that 10^k <= |d| < 10^(k+1),
+// i.e. floor(log10(|d|)), computed exactly from the coefficient's digit count
+// and the scale: 999 gives 2, 1000 gives 3 and 0.05 gives -2. It is an int64
+// because k can pass the int32 range, as it does for 5e+2147483648.
+// Zero has no order of magnitude; by convention it returns math.MinInt64,
+// so callers that may see zero should check IsZero first.
+func (d Decimal) OrderOfMagnitude() int64 {
+	if d.IsZero() {
+		return math.MinInt64
+	}
+	return int64(numDigits(d.unscaledValue)) - 1 - int64(d.scale)
+}
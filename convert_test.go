@@ -0,0 +1,449 @@
+package decimal
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"testing"
+)
+
+func TestDecimal_FitsSignedBits(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		m, n  uint
+		want  bool
+	}{
+		{"Q7.8 zero", "0", 7, 8, true},
+		{"Q7.8 max", "127.99609375", 7, 8, true},
+		{"Q7.8 just above max", "128", 7, 8, false},
+		{"Q7.8 min", "-128", 7, 8, true},
+		{"Q7.8 just below min", "-128.00390625", 7, 8, false},
+		{"Q7.8 smallest step", "0.00390625", 7, 8, true},
+		{"Q7.8 finer than step", "0.001953125", 7, 8, false},
+		{"Q7.8 not binary fraction", "0.1", 7, 8, false},
+		{"Q7.8 trailing zeros", "1.5000", 7, 8, true},
+		{"Q15.0 negative scale", "3e+3", 15, 0, true},
+		{"Q15.0 negative scale overflow", "4e+4", 15, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := d.FitsSignedBits(tt.m, tt.n); got != tt.want {
+				t.Errorf("FitsSignedBits(%d, %d) for %s = %t, want %t", tt.m, tt.n, tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_BasisPoints(t *testing.T) {
+	tests := []struct {
+		input  string
+		wantBP string
+	}{
+		{"0.0125", "125"},
+		{"1", "10000"},
+		{"-0.0001", "-1"},
+		{"0.00005", "0.5"},
+		{"0", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			want, err := NewFromString(tt.wantBP)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.wantBP, err)
+			}
+			bp := d.ToBasisPoints()
+			if !bp.Equal(want) {
+				t.Errorf("ToBasisPoints(%s) = %v scale %d, want %s", tt.input, bp.unscaledValue, bp.scale, tt.wantBP)
+			}
+			back := FromBasisPoints(bp)
+			if !back.StrictEqual(d) {
+				t.Errorf("FromBasisPoints(ToBasisPoints(%s)) = %v scale %d, want %v scale %d",
+					tt.input, back.unscaledValue, back.scale, d.unscaledValue, d.scale)
+			}
+		})
+	}
+}
+
+func TestDecimal_OrderOfMagnitude(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{"999", 2},
+		{"1000", 3},
+		{"1", 0},
+		{"9.99", 0},
+		{"0.05", -2},
+		{"0.1", -1},
+		{"-0.05", -2},
+		{"-12345", 4},
+		{"1.000", 0},
+		{"1e+10", 10},
+		{"5e-20", -20},
+		{"0", math.MinInt64},
+		{"0.000", math.MinInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := d.OrderOfMagnitude(); got != tt.want {
+				t.Errorf("OrderOfMagnitude(%s) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_OrderOfMagnitudeExtremeScale(t *testing.T) {
+	tests := []struct {
+		input Decimal
+		want  int64
+	}{
+		{New(5, math.MinInt32), math.MaxInt32 + 1},
+		{New(12345, math.MinInt32), math.MaxInt32 + 5},
+		{New(5, math.MaxInt32), math.MinInt32 + 1},
+		{New(12345, math.MaxInt32), math.MinInt32 + 5},
+	}
+	for _, tt := range tests {
+		if got := tt.input.OrderOfMagnitude(); got != tt.want {
+			t.Errorf("OrderOfMagnitude(%se%d) = %d, want %d", tt.input.Coefficient(), tt.input.Exponent(), got, tt.want)
+		}
+	}
+}
+
+func TestDecimal_Float64(t *testing.T) {
+	tests := []struct {
+		input     string
+		want      float64
+		wantExact bool
+	}{
+		{"0.5", 0.5, true},
+		{"0.25", 0.25, true},
+		{"-1.75", -1.75, true},
+		{"1e+3", 1000, true},
+		{"0", 0, true},
+		{"0.1", 0.1, false},
+		{"0.3333333333333333333333", 1.0 / 3, false},
+		{"1e+400", math.Inf(1), false},
+		{"-1e+400", math.Inf(-1), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got, exact := d.Float64()
+			if got != tt.want || exact != tt.wantExact {
+				t.Errorf("Float64(%s) = (%v, %t), want (%v, %t)", tt.input, got, exact, tt.want, tt.wantExact)
+			}
+		})
+	}
+}
+
+func TestDecimal_InexactFloat64(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+	}{
+		{"0.5", 0.5},
+		{"-1.75", -1.75},
+		{"0", 0},
+		{"0.1", 0.1},
+		{"0.3333333333333333333333", 1.0 / 3},
+		{"123456789.123456789", 123456789.123456789},
+		{"1e+400", math.Inf(1)},
+		{"-1e+400", math.Inf(-1)},
+		{"1e-400", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.InexactFloat64()
+			if got != tt.want {
+				t.Errorf("InexactFloat64(%s) = %v, want %v", tt.input, got, tt.want)
+			}
+			if f, _ := d.Float64(); got != f {
+				t.Errorf("InexactFloat64(%s) = %v, but Float64 returned %v", tt.input, got, f)
+			}
+		})
+	}
+}
+
+func TestDecimal_Int64(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123", 123, false},
+		{"-123", -123, false},
+		{"12.00", 12, false},
+		{"12e+3", 12000, false},
+		{"9223372036854775807", math.MaxInt64, false},
+		{"-9223372036854775808", math.MinInt64, false},
+		{"9223372036854775808", 0, true},
+		{"-9223372036854775809", 0, true},
+		{"1e+19", 0, true},
+		{"12.5", 0, true},
+		{"-0.001", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got, err := d.Int64()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Int64(%s) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Int64(%s) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Uint64(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    uint64
+		wantErr bool
+	}{
+		{"0", 0, false},
+		{"123.000", 123, false},
+		{"18446744073709551615", math.MaxUint64, false},
+		{"18446744073709551616", 0, true},
+		{"2e+19", 0, true},
+		{"-1", 0, true},
+		{"0.5", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got, err := d.Uint64()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Uint64(%s) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Uint64(%s) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_BigInt(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"1.99", "1"},
+		{"-1.99", "-1"},
+		{"0.5", "0"},
+		{"42", "42"},
+		{"1.5e+3", "1500"},
+		{"-7e+2", "-700"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			before := d.String()
+			got := d.BigInt()
+			if got.String() != tt.want {
+				t.Errorf("BigInt() = %v, want %v", got, tt.want)
+			}
+			got.Add(got, big.NewInt(1000))
+			if d.String() != before {
+				t.Errorf("modifying BigInt() changed the receiver from %s to %s", before, d)
+			}
+		})
+	}
+}
+
+func TestDecimal_Rat(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"0.25", "1/4"},
+		{"-1.5", "-3/2"},
+		{"0.1", "1/10"},
+		{"3", "3/1"},
+		{"1.2e+2", "120/1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			before := d.String()
+			got := d.Rat()
+			if got.String() != tt.want {
+				t.Errorf("Rat() = %v, want %v", got, tt.want)
+			}
+			got.Num().Add(got.Num(), big.NewInt(1000))
+			got.Denom().Add(got.Denom(), big.NewInt(1000))
+			if d.String() != before || d.Rat().String() != tt.want {
+				t.Errorf("modifying Rat() changed the receiver from %s to %s", before, d)
+			}
+		})
+	}
+}
+
+func TestDecimal_CoefficientExponentScale(t *testing.T) {
+	tests := []struct {
+		input        string
+		wantCoef     string
+		wantScale    int32
+		wantExponent int32
+	}{
+		{"1.23", "123", 2, -2},
+		{"-0.050", "-50", 3, -3},
+		{"42", "42", 0, 0},
+		{"1.2e+5", "12", -4, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			coef := d.Coefficient()
+			if coef.String() != tt.wantCoef {
+				t.Errorf("Coefficient() = %v, want %v", coef, tt.wantCoef)
+			}
+			if got := d.Scale(); got != tt.wantScale {
+				t.Errorf("Scale() = %d, want %d", got, tt.wantScale)
+			}
+			if got := d.Exponent(); got != tt.wantExponent {
+				t.Errorf("Exponent() = %d, want %d", got, tt.wantExponent)
+			}
+			coef.SetInt64(999)
+			if d.unscaledValue.String() != tt.wantCoef {
+				t.Errorf("modifying Coefficient() changed the receiver to %v", d.unscaledValue)
+			}
+		})
+	}
+}
+
+func TestDecimal_NumDigits(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"0", 1},
+		{"0.00", 1},
+		{"100", 3},
+		{"-100", 3},
+		{"1.500", 4},
+		{"-0.05", 1},
+		{"1.2e+5", 2},
+		{"123456789012345678901234567890.123456789", 39},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := d.NumDigits(); got != tt.want {
+				t.Errorf("NumDigits() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_StorageSize(t *testing.T) {
+	word := bits.UintSize / 8
+	huge := Decimal{unscaledValue: new(big.Int).Lsh(big.NewInt(1), 200), scale: 3}
+	tests := []struct {
+		name  string
+		input Decimal
+		want  int
+	}{
+		{"zero", New(0, 5), 4},
+		{"one word", New(12345, 2), word + 4},
+		{"negative one word", New(-12345, 2), word + 4},
+		{"201 bits", huge, (200/bits.UintSize+1)*word + 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.StorageSize(); got != tt.want {
+				t.Errorf("StorageSize() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+	if New(1, 0).StorageSize() >= huge.StorageSize() {
+		t.Errorf("StorageSize() does not grow with the coefficient")
+	}
+}
+
+func TestDecimal_BigFloat(t *testing.T) {
+	tests := []struct {
+		input string
+		prec  uint
+	}{
+		{"2.5", 53},
+		{"-0.375", 53},
+		{"1024", 16},
+		{"0.0625", 8},
+		{"1.5E+30", 200},
+		{"0", 53},
+		{"-12345678901234567890.125", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d := mustParse(t, tt.input)
+			f := d.BigFloat(tt.prec)
+			if f.Acc() != big.Exact {
+				t.Errorf("BigFloat(%d) of %s accuracy = %v, want Exact", tt.prec, tt.input, f.Acc())
+			}
+			back, err := NewFromBigFloat(f, max(d.scale, 0), RoundUnnecessary)
+			if err != nil {
+				t.Fatalf("NewFromBigFloat() unexpected error: %v", err)
+			}
+			if !back.Equal(d) {
+				t.Errorf("NewFromBigFloat(BigFloat(%d)) = %v, want %v", tt.prec, back, d)
+			}
+		})
+	}
+}
+
+func TestDecimal_BigFloatInexact(t *testing.T) {
+	f := New(1, 1).BigFloat(24)
+	if f.Prec() != 24 {
+		t.Errorf("BigFloat(24) precision = %d, want 24", f.Prec())
+	}
+	if f.Acc() != big.Above {
+		t.Errorf("BigFloat(24) of 0.1 accuracy = %v, want Above", f.Acc())
+	}
+	if got := f.Text('g', 10); got != "0.1000000015" {
+		t.Errorf("BigFloat(24) of 0.1 = %s, want 0.1000000015", got)
+	}
+	if got, _ := New(1, 1).BigFloat(53).Float64(); got != 0.1 {
+		t.Errorf("BigFloat(53).Float64() of 0.1 = %v, want 0.1", got)
+	}
+}
@@ -1,13 +1,22 @@
 package decimal
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"math"
 	"math/big"
+	"strconv"
 	"strings"
 	"sync"
 )
 
+// Decimal is an arbitrary-precision decimal number, the value
+// unscaledValue × 10^-scale. The public API treats Decimals as immutable:
+// apart from the explicit AddInPlace, SubInPlace and MulInPlace, no method
+// modifies its receiver or arguments, but results may share the
+// underlying big.Int with an input, as Shift and Abs do, so plain struct
+// copies are only independent as long as nothing mutates them. Use Copy
+// for a deep duplicate.
 type Decimal struct {
 	unscaledValue *big.Int
 	scale         int32
@@ -17,8 +26,35 @@ type Decimal struct {
 var (
 	powersOfTenMutex sync.RWMutex
 	powersOfTen      = make(map[int32]*big.Int, 128) // Increase initial capacity
+	// powersOfTenLimit is the number of cached powers, 10^0 through
+	// 10^(powersOfTenLimit-1); larger powers are computed on every call
+	powersOfTenLimit int32 = DefaultPow10CacheSize
 )
 
+// DefaultPow10CacheSize is the number of powers of ten cached by default.
+const DefaultPow10CacheSize = 1024
+
+// minPow10CacheSize keeps the powers pre-populated at init, 10^0 to 10^38.
+const minPow10CacheSize = 39
+
+// SetPow10CacheSize sets how many powers of ten the package caches: 10^0
+// through 10^(n-1) are kept once computed, and larger powers are computed
+// each time they are needed without being stored, so huge exponents or
+// precisions cannot grow memory without bound. Shrinking the cache drops the
+// entries beyond the new size. Values of n below 39 are treated as 39, since
+// 10^0 to 10^38 are always cached. The default is DefaultPow10CacheSize.
+func SetPow10CacheSize(n int) {
+	size := int32(min(max(n, minPow10CacheSize), math.MaxInt32))
+	powersOfTenMutex.Lock()
+	defer powersOfTenMutex.Unlock()
+	powersOfTenLimit = size
+	for k := range powersOfTen {
+		if k >= size {
+			delete(powersOfTen, k)
+		}
+	}
+}
+
 func init() {
 	// Pre-calculate more powers
 	for i := int32(0); i <= 38; i++ { // Common powers for uint128
@@ -38,8 +74,13 @@ func pow10(n int32) *big.Int {
 		powersOfTenMutex.RUnlock()
 		return p
 	}
+	cacheable := n < powersOfTenLimit
 	powersOfTenMutex.RUnlock()
 
+	if !cacheable {
+		return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+	}
+
 	powersOfTenMutex.Lock()
 	defer powersOfTenMutex.Unlock()
 
@@ -49,10 +90,141 @@ func pow10(n int32) *big.Int {
 	}
 
 	p := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
-	powersOfTen[n] = p
+	if n < powersOfTenLimit {
+		powersOfTen[n] = p
+	}
 	return p
 }
 
+// scratchPool recycles temporary big.Ints used while aligning scales. Only
+// values that never escape into a returned Decimal may be put back.
+var scratchPool = sync.Pool{New: func() any { return new(big.Int) }}
+
+// maxScratchBits bounds the size of pooled values, so that one huge operand
+// does not keep a large buffer alive in the pool.
+const maxScratchBits = 1 << 16
+
+func getScratch() *big.Int {
+	return scratchPool.Get().(*big.Int)
+}
+
+func putScratch(x *big.Int) {
+	if x.BitLen() <= maxScratchBits {
+		scratchPool.Put(x)
+	}
+}
+
+// alignCoefficients returns the coefficients of d and other at scale, the
+// larger of their differing scales. The operand with the smaller scale is
+// rescaled into scratch, which the caller must release with putScratch once
+// x and y are no longer needed; neither x nor y may end up in a result.
+func alignCoefficients(d, other Decimal) (x, y, scratch *big.Int, scale int32) {
+	scratch = getScratch()
+	if d.scale < other.scale {
+		return scratch.Mul(d.unscaledValue, pow10(other.scale-d.scale)), other.unscaledValue, scratch, other.scale
+	}
+	return d.unscaledValue, scratch.Mul(other.unscaledValue, pow10(d.scale-other.scale)), scratch, d.scale
+}
+
+// orZero returns d, or zero at d's scale if d has a nil coefficient, as the
+// zero value Decimal{} does, so that a declared but unset Decimal behaves as
+// 0 in arithmetic and comparisons.
+func (d Decimal) orZero() Decimal {
+	if d.unscaledValue == nil {
+		return Decimal{unscaledValue: new(big.Int), scale: d.scale}
+	}
+	return d
+}
+
+// Copy returns a deep copy of d whose coefficient does not share memory
+// with d's.
+func (d Decimal) Copy() Decimal {
+	d = d.orZero()
+	return Decimal{
+		unscaledValue: new(big.Int).Set(d.unscaledValue),
+		scale:         d.scale,
+	}
+}
+
+// rescale returns d expressed with the given scale. Increasing the scale is
+// exact; decreasing it drops digits through Round with RoundDown, so values
+// are truncated toward zero (-1.5 becomes -1) and never floored.
+// If the scale is already the requested one, d is returned as is.
+func (d Decimal) rescale(newScale int32) Decimal {
+	d = d.orZero()
+	if d.scale == newScale {
+		return d
+	}
+	if newScale < d.scale {
+		return d.Round(newScale, RoundDown)
+	}
+	return Decimal{
+		unscaledValue: new(big.Int).Mul(d.unscaledValue, pow10(newScale-d.scale)),
+		scale:         newScale,
+	}
+}
+
+// trimTrailingZeros removes trailing zero digits from the coefficient while
+// the scale stays above minScale. The numeric value is unchanged.
+func (d Decimal) trimTrailingZeros(minScale int32) Decimal {
+	d = d.orZero()
+	if d.scale <= minScale {
+		return d
+	}
+	coefficient := new(big.Int).Set(d.unscaledValue)
+	scale := d.scale
+	ten := big.NewInt(10)
+	quo, rem := new(big.Int), new(big.Int)
+	for scale > minScale {
+		quo.QuoRem(coefficient, ten, rem)
+		if rem.Sign() != 0 {
+			break
+		}
+		coefficient, quo = quo, coefficient
+		scale--
+	}
+	return Decimal{
+		unscaledValue: coefficient,
+		scale:         scale,
+	}
+}
+
+// StripTrailingZeros returns d with trailing zero digits removed from the
+// coefficient and the scale lowered to match, so 1.2300 becomes 1.23 and
+// 0.00 becomes 0. The scale never drops below zero: 1500 keeps its
+// representation. The numeric value is unchanged.
+func (d Decimal) StripTrailingZeros() Decimal {
+	if d.IsZero() && d.scale > 0 {
+		return Decimal{unscaledValue: new(big.Int), scale: 0}
+	}
+	return d.trimTrailingZeros(0)
+}
+
+// Normalize returns the canonical representation of d, the one every
+// numerically equal Decimal shares:
+//
+//   - zero, at any scale, is coefficient 0 with scale 0;
+//   - any other value has the smallest possible scale, so its coefficient
+//     has no trailing zero digit. The scale may become negative: 1500 is
+//     normalized to coefficient 15 with scale -2.
+//
+// Normalized Decimals are equal exactly when StrictEqual reports true, which
+// makes them safe to use as map keys via their coefficient and scale.
+func (d Decimal) Normalize() Decimal {
+	if d.IsZero() {
+		return Decimal{unscaledValue: new(big.Int), scale: 0}
+	}
+	return d.trimTrailingZeros(math.MinInt32)
+}
+
+// IsCanonical reports whether d is already in the form Normalize returns.
+func (d Decimal) IsCanonical() bool {
+	if d.IsZero() {
+		return d.scale == 0
+	}
+	return new(big.Int).Rem(d.unscaledValue, big.NewInt(10)).Sign() != 0
+}
+
 func New(val int64, scale int32) Decimal {
 	return Decimal{
 		unscaledValue: big.NewInt(val),
@@ -60,6 +232,42 @@ func New(val int64, scale int32) Decimal {
 	}
 }
 
+// Const returns the Decimal coefficient * 10^(-scale). It is identical to New
+// and exists to make package-level constant declarations read clearly:
+//
+//	var VATRate = decimal.Const(21, 2) // 0.21
+//
+// Decimals built this way are safe to share between goroutines, because no
+// method of the public API modifies its receiver or arguments.
+func Const(coefficient int64, scale int32) Decimal {
+	return New(coefficient, scale)
+}
+
+// Commonly used values. They are shared singletons and, like every Decimal,
+// are never modified by the package's operations.
+var (
+	Zero    = Const(0, 0)
+	One     = Const(1, 0)
+	Ten     = Const(10, 0)
+	Hundred = Const(100, 0)
+)
+
+// NewFromPow10 returns 10^n exactly, for either sign of n: NewFromPow10(3) is
+// 1000 and NewFromPow10(-3) is 0.001. The result has coefficient 1 and scale
+// -n, so no power of ten is computed. It panics if n is math.MinInt32, whose
+// scale is not representable.
+func NewFromPow10(n int32) Decimal {
+	if n == math.MinInt32 {
+		panic(fmt.Sprintf("NewFromPow10: exponent %d is out of range", n))
+	}
+	return Decimal{
+		unscaledValue: big.NewInt(1),
+		scale:         -n,
+	}
+}
+
+// NewFromInt returns val at scale 0. It takes an int32 for compatibility
+// with existing callers; NewFromIntGo accepts a plain int.
 func NewFromInt(val int32) Decimal {
 	return Decimal{
 		unscaledValue: big.NewInt(int64(val)),
@@ -67,6 +275,17 @@ func NewFromInt(val int32) Decimal {
 	}
 }
 
+// NewFromIntGo returns val at scale 0. Unlike NewFromInt it takes Go's
+// default integer type, so len(items) or an untyped constant can be passed
+// without a conversion, and it covers the full 64-bit range where int is 64
+// bits wide.
+func NewFromIntGo(val int) Decimal {
+	return Decimal{
+		unscaledValue: big.NewInt(int64(val)),
+		scale:         0,
+	}
+}
+
 func NewFromInt64(val int64) Decimal {
 	return Decimal{
 		unscaledValue: big.NewInt(val),
@@ -101,13 +320,16 @@ func NewFromBigInt(val *big.Int, scale int32) (Decimal, error) {
 // Decimal{unscaledValue: 123, scale: -3} representing 123 * 10^3 = 123000
 // if scale is positive, it means the number has that many digits after the decimal point.
 // if scale is negative, we times the number by 10^(scale) to get the actual value.
+// Leading and trailing whitespace, ASCII or Unicode, is ignored, as values
+// from CSV cells and form fields often carry it; spaces inside the number
+// are still an error.
 func NewFromString(val string) (Decimal, error) {
+	originalVal := val
+	val = strings.TrimSpace(val)
 	if val == "" {
-		return Decimal{}, fmt.Errorf("cannot parse empty string to Decimal")
+		return Decimal{}, fmt.Errorf("%w: cannot parse empty string to Decimal", ErrInvalidFormat)
 	}
 
-	originalVal := val
-
 	isNegative := false
 	switch val[0] {
 	case '-':
@@ -119,6 +341,12 @@ func NewFromString(val string) (Decimal, error) {
 		// No sign, continue with the original value
 	}
 
+	// big.Int would reject these with a generic message; name the problem
+	switch strings.ToLower(val) {
+	case "inf", "infinity", "nan":
+		return Decimal{}, fmt.Errorf("%w: decimal does not support infinity/NaN: %q", ErrInvalidFormat, originalVal)
+	}
+
 	// Check for scientific notation 'e' or 'E'
 	eIndex := -1
 	for i, r := range val {
@@ -135,19 +363,19 @@ func NewFromString(val string) (Decimal, error) {
 		mantissaStr = val[:eIndex]
 		exponentStr := val[eIndex+1:]
 
-		if exponentStr == "" {
-			return Decimal{}, fmt.Errorf("invalid scientific notation: missing exponent after 'e' in %q", originalVal)
+		if strings.TrimLeft(exponentStr, "+-") == "" {
+			return Decimal{}, fmt.Errorf("%w: invalid scientific notation: missing exponent digits in %q", ErrInvalidFormat, originalVal)
 		}
 
 		// Parse exponent
 		expBigInt := new(big.Int)
 		_, ok := expBigInt.SetString(exponentStr, 10)
 		if !ok {
-			return Decimal{}, fmt.Errorf("invalid exponent in scientific notation: %q", originalVal)
+			return Decimal{}, fmt.Errorf("%w: invalid exponent in scientific notation: %q", ErrInvalidFormat, originalVal)
 		}
 		// Convert to int64, checking for overflow if scale can be int32
 		if !expBigInt.IsInt64() {
-			return Decimal{}, fmt.Errorf("exponent out of int64 range: %q", originalVal)
+			return Decimal{}, fmt.Errorf("%w: exponent out of int64 range: %q", ErrScaleOverflow, originalVal)
 		}
 		exponent = expBigInt.Int64()
 
@@ -176,49 +404,75 @@ func NewFromString(val string) (Decimal, error) {
 			// Ensure fractional part contains only digits
 			for _, r := range fractionalPart {
 				if r < '0' || r > '9' {
-					return Decimal{}, fmt.Errorf("invalid character in fractional part: %q", originalVal)
+					return Decimal{}, fmt.Errorf("%w: invalid character in fractional part: %q", ErrInvalidFormat, originalVal)
 				}
 			}
 			unscaledStr = integerPart + fractionalPart
 			mantissaScale = int32(len(fractionalPart))
 		}
 	default:
-		return Decimal{}, fmt.Errorf("invalid decimal string format: %q (multiple decimal points in mantissa)", originalVal)
+		return Decimal{}, fmt.Errorf("%w: %q has multiple decimal points in mantissa", ErrInvalidFormat, originalVal)
 	}
 
 	unscaledValue := new(big.Int)
 	_, ok := unscaledValue.SetString(unscaledStr, 10)
 	if !ok {
-		return Decimal{}, fmt.Errorf("invalid characters in number part: %q", originalVal)
+		return Decimal{}, fmt.Errorf("%w: invalid characters in number part: %q", ErrInvalidFormat, originalVal)
 	}
 
 	if isNegative {
 		unscaledValue.Neg(unscaledValue)
 	}
 
-	finalScale := mantissaScale - int32(exponent)
+	finalScale := int64(mantissaScale) - exponent
+	if finalScale > math.MaxInt32 || finalScale < math.MinInt32 {
+		return Decimal{}, fmt.Errorf("%w: exponent out of range: scale %d of %q does not fit in int32", ErrScaleOverflow, finalScale, originalVal)
+	}
 
 	return Decimal{
 		unscaledValue: unscaledValue,
-		scale:         finalScale,
+		scale:         int32(finalScale),
 	}, nil
 }
 
+// NewFromStringWithSource parses val like NewFromString, but first strips
+// surrounding whitespace and underscore digit separators (e.g. " 1_234.50 ").
+// It returns the parsed Decimal together with the normalized string that was
+// actually parsed, so import tooling can report the exact literal it used.
+func NewFromStringWithSource(val string) (Decimal, string, error) {
+	source := strings.ReplaceAll(strings.TrimSpace(val), "_", "")
+	d, err := NewFromString(source)
+	if err != nil {
+		return Decimal{}, source, err
+	}
+	return d, source, nil
+}
+
 // NewFromFloat64 creates a new Decimal from a float64 value.
 // This conversion aims for the most precise decimal representation of the float64's binary value.
-// It converts the float64 to a *big.Rat and then uses NewFromRat.
-// The default precision for this conversion is set to 64 decimal places, which is usually
-// sufficient to capture the full precision of a float64 (approx 15-17 digits).
+// It is NewFromFloat64WithPrecision with 64 decimal places and RoundHalfEven;
+// 64 places is usually sufficient to capture the full precision of a float64
+// (approx 15-17 digits). Zero is returned at scale 0.
 func NewFromFloat64(val float64) (Decimal, error) {
+	if val == 0 {
+		return Decimal{unscaledValue: big.NewInt(0), scale: 0}, nil
+	}
+	// RoundHalfEven is a good default for general numerical conversions.
+	return NewFromFloat64WithPrecision(val, 64, RoundHalfEven)
+}
+
+// NewFromFloat64WithPrecision converts val to a Decimal with exactly
+// precision fractional digits, rounding its exact binary value with mode, so
+// NewFromFloat64WithPrecision(0.1, 2, RoundHalfUp) is 0.10 without a separate
+// Round step. It returns an error for infinities, NaN, a negative precision,
+// and RoundUnnecessary when the binary value needs more digits.
+func NewFromFloat64WithPrecision(val float64, precision int32, mode RoundingMode) (Decimal, error) {
 	if math.IsInf(val, 0) {
 		return Decimal{}, fmt.Errorf("cannot convert infinity to Decimal")
 	}
 	if math.IsNaN(val) {
 		return Decimal{}, fmt.Errorf("cannot convert NaN to Decimal")
 	}
-	if val == 0 {
-		return Decimal{unscaledValue: big.NewInt(0), scale: 0}, nil
-	}
 
 	// Convert float64 to its exact rational representation.
 	// This captures the exact binary value of the float64.
@@ -227,12 +481,40 @@ func NewFromFloat64(val float64) (Decimal, error) {
 		// This case should ideally not happen for valid non-NaN/Inf floats
 		return Decimal{}, fmt.Errorf("failed to convert float64 to *big.Rat: %v", val)
 	}
+	return NewFromRat(rat, precision, mode)
+}
 
-	// Convert the *big.Rat to Decimal with a sufficiently high precision
-	// and a default rounding mode. 64 decimal places is chosen as it's
-	// more than enough to exactly represent any float64 in decimal form.
-	// RoundHalfEven is a good default for general numerical conversions.
-	return NewFromRat(rat, 64, RoundHalfEven)
+// NewFromBigFloat converts f to a Decimal with exactly precision fractional
+// digits, rounding with mode. A finite big.Float is a binary fraction, so it
+// is taken exactly through big.Rat and only the final step rounds. It
+// returns an error for a nil f, an infinite f, and the NewFromRat errors.
+// big.Float has no NaN value: operations that would produce one panic with
+// big.ErrNaN instead.
+func NewFromBigFloat(f *big.Float, precision int32, mode RoundingMode) (Decimal, error) {
+	if f == nil {
+		return Decimal{}, fmt.Errorf("cannot create Decimal from nil *big.Float")
+	}
+	if f.IsInf() {
+		return Decimal{}, fmt.Errorf("cannot convert infinity to Decimal")
+	}
+	rat, _ := f.Rat(nil)
+	return NewFromRat(rat, precision, mode)
+}
+
+// NewFromFloat64Canonical creates a Decimal from val via its 18-significant-
+// digit scientific form, strconv.FormatFloat(val, 'e', 17, 64). That form is
+// fully specified by the float's bits, so the same float64 yields an
+// identical Decimal (coefficient and scale) on every platform, which makes it
+// suitable for reproducible pipelines and golden files. The Decimal always
+// carries 18 significant digits: 0.1 becomes 0.100000000000000006.
+func NewFromFloat64Canonical(val float64) (Decimal, error) {
+	if math.IsInf(val, 0) {
+		return Decimal{}, fmt.Errorf("cannot convert infinity to Decimal")
+	}
+	if math.IsNaN(val) {
+		return Decimal{}, fmt.Errorf("cannot convert NaN to Decimal")
+	}
+	return NewFromString(strconv.FormatFloat(val, 'e', 17, 64))
 }
 
 // NewFromRat creates a new Decimal from a *big.Rat (rational number).
@@ -283,68 +565,19 @@ func NewFromRat(val *big.Rat, precision int32, roundingMode RoundingMode) (Decim
 
 	// If RoundUnnecessary and remainder is not zero, return error
 	if roundingMode == RoundUnnecessary {
-		return Decimal{}, fmt.Errorf("rounding necessary for NewFromRat with RoundUnnecessary mode: %s at precision %d", val.String(), precision)
-	}
-
-	// absRemainder is used for comparison against half of the denominator
-	absRemainder := new(big.Int).Abs(remainder)
-
-	// halfDivisor is divisor / 2
-	halfDivisor := new(big.Int).Rsh(den, 1) // Efficiently divide by 2
-
-	// Compare abs(remainder) with (denominator / 2)
-	cmpResult := absRemainder.Cmp(halfDivisor) // -1 if absR < halfDivisor, 0 if absR == halfDivisor, 1 if absR > halfDivisor
-
-	// Check if exactly halfway (abs(remainder) * 2 == denominator)
-	// This is important for HalfUp, HalfDown, HalfEven.
-	isHalfway := (cmpResult == 0) && (new(big.Int).Mul(absRemainder, big.NewInt(2)).Cmp(den) == 0)
-
-	// Determine if we need to increment the quotient based on rounding mode
-	shouldIncrement := false
-	quotientSign := quotient.Sign() // -1 for negative, 0 for zero, 1 for positive
-
-	switch roundingMode {
-	case RoundUp:
-		// Round away from zero
-		shouldIncrement = true
-	case RoundDown:
-		// Round towards zero (no increment needed if remainder exists)
-		shouldIncrement = false // Already truncated by QuoRem
-	case RoundCeiling:
-		// Round towards positive infinity
-		shouldIncrement = quotientSign >= 0 // Increment if positive or zero
-	case RoundFloor:
-		// Round towards negative infinity
-		shouldIncrement = quotientSign < 0 // Increment if negative
-	case RoundHalfUp:
-		// Round towards nearest neighbor; if equidistant, round up.
-		shouldIncrement = cmpResult == 1 || (cmpResult == 0 && isHalfway)
-	case RoundHalfDown:
-		// Round towards nearest neighbor; if equidistant, round down.
-		shouldIncrement = cmpResult == 1
-	case RoundHalfEven:
-		// Round towards nearest neighbor; if equidistant, round to even.
-		if cmpResult == 1 { // Remainder > halfDivisor
-			shouldIncrement = true
-		} else if cmpResult == 0 && isHalfway { // Exactly halfway
-			// Check if the last digit of the quotient is odd
-			// Note: This checks if the *quotient* is odd/even, not the remainder.
-			// For RoundHalfEven, you check the *truncated* digit before rounding.
-			// If the truncated digit is even, round down; if odd, round up.
-			// This is equivalent to checking if quotient is even/odd.
-			lastDigitOfQuotient := new(big.Int).Mod(quotient, big.NewInt(2))
-			shouldIncrement = lastDigitOfQuotient.Cmp(big.NewInt(1)) == 0 // Increment if odd
-		}
-	default:
+		return Decimal{}, fmt.Errorf("%w for NewFromRat with RoundUnnecessary mode: %s at precision %d", ErrRoundingNecessary, val.String(), precision)
+	}
+
+	if !roundingMode.valid() {
 		return Decimal{}, fmt.Errorf("unsupported rounding mode: %v", roundingMode)
 	}
 
-	// Apply increment if determined
-	if shouldIncrement {
-		if quotientSign >= 0 { // Positive or zero quotient
-			quotient.Add(quotient, big.NewInt(1))
-		} else { // Negative quotient
+	// The remainder carries the sign of val, so step away from zero in that direction
+	if roundingMode.shouldRoundUp(quotient, remainder, den) {
+		if remainder.Sign() < 0 {
 			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
 		}
 	}
 
@@ -354,17 +587,135 @@ func NewFromRat(val *big.Rat, precision int32, roundingMode RoundingMode) (Decim
 	}, nil
 }
 
+// MustFromString is like NewFromString but panics if s cannot be parsed. It
+// is meant for package-level values, tests and other places where a bad
+// literal is a programming error, in the manner of regexp.MustCompile.
+func MustFromString(s string) Decimal {
+	d, err := NewFromString(s)
+	if err != nil {
+		panic(fmt.Sprintf("MustFromString: %v", err))
+	}
+	return d
+}
+
+// MustFromRat is like NewFromRat but panics if the conversion fails.
+func MustFromRat(r *big.Rat, prec int32, mode RoundingMode) Decimal {
+	d, err := NewFromRat(r, prec, mode)
+	if err != nil {
+		panic(fmt.Sprintf("MustFromRat: %v", err))
+	}
+	return d
+}
+
 func NewFromBytes(val []byte) (Decimal, error) {
 	if len(val) == 0 {
-		return Decimal{}, fmt.Errorf("cannot parse empty bytes to Decimal")
+		return Decimal{}, fmt.Errorf("%w: cannot parse empty bytes to Decimal", ErrInvalidFormat)
 	}
 	// Reuse NewString logic but work directly with bytes
 	// to avoid string conversion
 	return NewFromString(string(val))
 }
 
+// NewFromRunes parses a rune slice, as produced by lexers that work on
+// []rune, using the same rules as NewFromString.
+func NewFromRunes(val []rune) (Decimal, error) {
+	if len(val) == 0 {
+		return Decimal{}, fmt.Errorf("%w: cannot parse empty runes to Decimal", ErrInvalidFormat)
+	}
+	return NewFromString(string(val))
+}
+
+// NewFromPercentString parses a percentage such as "12.34%" into the ratio it
+// denotes, 0.1234, dividing by 100 exactly. The number before the "%" is
+// parsed with NewFromString; the "%" itself is required. Whitespace around
+// the whole value and between the number and the "%" is ignored, so
+// " 12.5 % " is 0.125.
+func NewFromPercentString(val string) (Decimal, error) {
+	number, ok := strings.CutSuffix(strings.TrimSpace(val), "%")
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: invalid percentage %q: missing %%", ErrInvalidFormat, val)
+	}
+	d, err := NewFromString(strings.TrimSpace(number))
+	if err != nil {
+		return Decimal{}, fmt.Errorf("invalid percentage %q: %w", val, err)
+	}
+	if d.scale > math.MaxInt32-2 {
+		return Decimal{}, fmt.Errorf("invalid percentage %q: %w", val, ErrScaleOverflow)
+	}
+	return d.Shift(-2), nil
+}
+
+// NewFromAccountingString parses an amount as financial exports write it,
+// with a negative wrapped in parentheses: "(123.45)" is -123.45. The integer
+// part may be grouped in thousands with commas, as FormatCurrencyWith
+// renders it, so "(1,234.50)" is -1234.50. The rest is parsed with
+// NewFromString. It returns an error for an unmatched or stray parenthesis,
+// a sign inside parentheses, and misplaced group separators.
+func NewFromAccountingString(val string) (Decimal, error) {
+	number := strings.TrimSpace(val)
+	negative := false
+	if strings.HasPrefix(number, "(") || strings.HasSuffix(number, ")") {
+		if len(number) < 2 || !strings.HasPrefix(number, "(") || !strings.HasSuffix(number, ")") {
+			return Decimal{}, fmt.Errorf("%w: invalid accounting amount %q: unmatched parenthesis", ErrInvalidFormat, val)
+		}
+		number = number[1 : len(number)-1]
+		negative = true
+		if strings.HasPrefix(number, "-") || strings.HasPrefix(number, "+") {
+			return Decimal{}, fmt.Errorf("%w: invalid accounting amount %q: sign inside parentheses", ErrInvalidFormat, val)
+		}
+	}
+	if strings.ContainsAny(number, "()") {
+		return Decimal{}, fmt.Errorf("%w: invalid accounting amount %q: stray parenthesis", ErrInvalidFormat, val)
+	}
+
+	number, err := removeGrouping(number)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("invalid accounting amount %q: %w", val, err)
+	}
+	d, err := NewFromString(number)
+	if err != nil {
+		return Decimal{}, fmt.Errorf("invalid accounting amount %q: %w", val, err)
+	}
+	return d.NegIf(negative), nil
+}
+
+// removeGrouping strips comma thousands separators from the integer part of
+// val, checking that every group after the first has exactly three digits.
+func removeGrouping(val string) (string, error) {
+	end := strings.IndexAny(val, ".eE")
+	if end < 0 {
+		end = len(val)
+	}
+	integer := val[:end]
+	if !strings.Contains(integer, ",") {
+		return val, nil
+	}
+
+	sign := ""
+	if integer[0] == '-' || integer[0] == '+' {
+		sign, integer = integer[:1], integer[1:]
+	}
+	groups := strings.Split(integer, ",")
+	if len(groups[0]) == 0 || len(groups[0]) > 3 {
+		return "", fmt.Errorf("%w: misplaced group separator", ErrInvalidFormat)
+	}
+	for _, g := range groups[1:] {
+		if len(g) != 3 {
+			return "", fmt.Errorf("%w: misplaced group separator", ErrInvalidFormat)
+		}
+	}
+	return sign + strings.Join(groups, "") + val[end:], nil
+}
+
 // Scan implements the sql.Scanner interface.
 // It allows our Decimal type to be scanned directly from a database query.
+// Besides string and []byte, it accepts the int64, float64 and *big.Int
+// values some drivers return for NUMERIC columns. A float64 has already lost
+// whatever the column held beyond its binary precision and is converted with
+// NewFromFloat64, so scanning 0.1 as a float64 gives its exact binary value
+// 0.1000000000000000055511151231257827021181583404541015625 padded with
+// zeros to 64 fractional digits, rather than 0.1; prefer scanning into a
+// string where the driver allows it.
 func (d *Decimal) Scan(value interface{}) error {
 	if value == nil {
 		// Handle a NULL value from the database
@@ -380,13 +731,19 @@ func (d *Decimal) Scan(value interface{}) error {
 		parsedDecimal, err = NewFromString(v)
 	case []byte:
 		parsedDecimal, err = NewFromBytes(v)
+	case int64:
+		parsedDecimal = NewFromInt64(v)
+	case float64:
+		parsedDecimal, err = NewFromFloat64(v)
+	case *big.Int:
+		parsedDecimal, err = NewFromBigInt(v, 0)
 	default:
 		// Return an error for unsupported types
 		return fmt.Errorf("unsupported type for Decimal Scan: %T", value)
 	}
 
 	if err != nil {
-		return fmt.Errorf("failed to scan string to Decimal: %w", err)
+		return fmt.Errorf("failed to scan %T to Decimal: %w", value, err)
 	}
 
 	// Set the receiver's fields to the newly parsed value
@@ -395,48 +752,67 @@ func (d *Decimal) Scan(value interface{}) error {
 	return nil
 }
 
+// Value implements the driver.Valuer interface.
+// It returns the canonical String form, which database/sql drivers store
+// losslessly in NUMERIC/DECIMAL columns. A zero-value Decimal is written as
+// "0"; use NullDecimal for columns that may be NULL.
+func (d Decimal) Value() (driver.Value, error) {
+	if d.unscaledValue == nil {
+		return "0", nil
+	}
+	return d.String(), nil
+}
+
 // String returns the string representation of the decimal.
 func (d Decimal) String() string {
+	return string(d.Append(nil))
+}
+
+// Append appends the String form of d to dst and returns the extended slice,
+// so that encoders writing many values can reuse one buffer instead of
+// allocating a string per value.
+func (d Decimal) Append(dst []byte) []byte {
 	if d.unscaledValue == nil {
-		return "<nil>"
+		return append(dst, "<nil>"...)
 	}
 
-	numStr := d.unscaledValue.String()
-	scale := d.scale
+	start := len(dst)
+	dst = d.unscaledValue.Append(dst, 10)
+	scale := int(d.scale)
 
-	if scale == 0 {
-		return numStr
+	// Handle negative scale (exponent) by writing the implied zeros
+	if scale <= 0 {
+		if d.unscaledValue.Sign() == 0 {
+			return dst
+		}
+		for ; scale < 0; scale++ {
+			dst = append(dst, '0')
+		}
+		return dst
 	}
 
-	// Handle negative scale (exponent)
-	if scale < 0 {
-		// Multiply by 10^(-scale)
-		exponent := -scale
-		multiplier := pow10(exponent)
-		// Create a new big.Int to avoid modifying the original
-		tempInt := new(big.Int).Set(d.unscaledValue)
-		tempInt.Mul(tempInt, multiplier)
-		return tempInt.String()
+	// Handle positive scale (fractional part)
+	digitsStart := start
+	if dst[start] == '-' {
+		digitsStart++
 	}
 
-	// Handle positive scale (fractional part)
-	if scale > 0 {
-		// Insert decimal point
-		integerPart := numStr[:len(numStr)-int(scale)]
-		fractionalPart := numStr[len(numStr)-int(scale):]
-
-		// Pad with leading zeros if integer part is empty
-		if integerPart == "" {
-			integerPart = "0"
+	// Pad with leading zeros so there is at least one integer digit
+	if n := len(dst) - digitsStart; n <= scale {
+		pad := scale - n + 1
+		for i := 0; i < pad; i++ {
+			dst = append(dst, '0')
 		}
-
-		// Pad with trailing zeros if fractional part is too short
-		if len(fractionalPart) < int(scale) {
-			fractionalPart = fractionalPart + strings.Repeat("0", int(scale)-len(fractionalPart))
+		copy(dst[digitsStart+pad:], dst[digitsStart:digitsStart+n])
+		for i := digitsStart; i < digitsStart+pad; i++ {
+			dst[i] = '0'
 		}
-
-		return integerPart + "." + fractionalPart
 	}
 
-	return ""
+	// Insert decimal point
+	point := len(dst) - scale
+	dst = append(dst, 0)
+	copy(dst[point+1:], dst[point:])
+	dst[point] = '.'
+	return dst
 }
@@ -1,9 +1,14 @@
 package decimal
 
 import (
+	"bytes"
+	"database/sql/driver"
 	"fmt"
+	"io"
 	"math"
 	"math/big"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -28,6 +33,76 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestConst(t *testing.T) {
+	tests := []struct {
+		coefficient int64
+		scale       int32
+	}{
+		{0, 0},
+		{21, 2},
+		{-12345, 3},
+		{7, -2},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d_%d", tt.coefficient, tt.scale), func(t *testing.T) {
+			got := Const(tt.coefficient, tt.scale)
+			want := New(tt.coefficient, tt.scale)
+			if got.unscaledValue.Cmp(want.unscaledValue) != 0 || got.scale != want.scale {
+				t.Errorf("Const(%d, %d) = %v scale %d, want %v scale %d",
+					tt.coefficient, tt.scale, got.unscaledValue, got.scale, want.unscaledValue, want.scale)
+			}
+		})
+	}
+}
+
+func TestSingletons(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"Zero", Zero, "0"},
+		{"One", One, "1"},
+		{"Ten", Ten, "10"},
+		{"Hundred", Hundred, "100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+			if tt.got.scale != 0 {
+				t.Errorf("%s scale = %d, want 0", tt.name, tt.got.scale)
+			}
+		})
+	}
+}
+
+// setCoefficient overwrites d's coefficient in place, which the public API
+// never does, to check that copies are independent.
+func setCoefficient(d Decimal, v int64) {
+	d.unscaledValue.SetInt64(v)
+}
+
+func TestDecimal_Copy(t *testing.T) {
+	for _, input := range []Decimal{New(12345, 2), New(-7, -3), New(0, 4)} {
+		t.Run(input.String(), func(t *testing.T) {
+			original := input.String()
+			c := input.Copy()
+			if !c.StrictEqual(input) {
+				t.Fatalf("Copy() = %v scale %d, want %v scale %d", c, c.scale, input, input.scale)
+			}
+			setCoefficient(c, 999)
+			if input.String() != original {
+				t.Errorf("mutating Copy() changed the original to %v, want %v", input, original)
+			}
+			if c.unscaledValue.Int64() != 999 {
+				t.Errorf("Copy() coefficient = %v after mutation, want 999", c.unscaledValue)
+			}
+		})
+	}
+}
+
 func TestNewInt(t *testing.T) {
 	tests := []struct {
 		input   int32
@@ -52,6 +127,30 @@ func TestNewInt(t *testing.T) {
 	}
 }
 
+func TestNewIntGo(t *testing.T) {
+	tests := []struct {
+		input   int
+		wantVal string
+	}{
+		{0, "0"},
+		{123, "123"},
+		{-123, "-123"},
+		{math.MaxInt, fmt.Sprint(math.MaxInt)},
+		{math.MinInt, fmt.Sprint(math.MinInt)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantVal, func(t *testing.T) {
+			got := NewFromIntGo(tt.input)
+			if got.unscaledValue.String() != tt.wantVal {
+				t.Errorf("NewIntGo(%v) = %v, want %v", tt.input, got.unscaledValue, tt.wantVal)
+			}
+			if got.scale != 0 {
+				t.Errorf("NewIntGo(%v) scale = %v, want 0", tt.input, got.scale)
+			}
+		})
+	}
+}
+
 func TestNewInt64(t *testing.T) {
 	tests := []struct {
 		input   int64
@@ -152,6 +251,24 @@ func TestNewString(t *testing.T) {
 		{"0.0", "0", 1, false},
 		{"+", "", 0, true},
 		{"-", "", 0, true},
+		{"1e+2147483648", "1", math.MinInt32, false},
+		{"1e-2147483647", "1", math.MaxInt32, false},
+		{"1.5e-2147483646", "15", math.MaxInt32, false},
+		{"1e+2147483649", "", 0, true},
+		{"1e-2147483648", "", 0, true},
+		{"1.5e-2147483647", "", 0, true},
+		{"1e3000000000", "", 0, true},
+		{"1e-3000000000", "", 0, true},
+		{" 123.45 ", "12345", 2, false},
+		{"\t-1.5\n", "-15", 1, false},
+		{"\r\n42\r\n", "42", 0, false},
+		{"\u00a01e2\u2003", "1", -2, false},
+		{"   ", "", 0, true},
+		{"\t\n", "", 0, true},
+		{"1 23", "", 0, true},
+		{"1. 23", "", 0, true},
+		{"- 5", "", 0, true},
+		{"1e 5", "", 0, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
@@ -171,6 +288,113 @@ func TestNewString(t *testing.T) {
 	}
 }
 
+func TestNewStringErrorMessages(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Inf", "decimal does not support infinity/NaN"},
+		{"-Infinity", "decimal does not support infinity/NaN"},
+		{"+inf", "decimal does not support infinity/NaN"},
+		{"NaN", "decimal does not support infinity/NaN"},
+		{" nan ", "decimal does not support infinity/NaN"},
+		{"1e", "missing exponent digits"},
+		{"1E+", "missing exponent digits"},
+		{"-2.5e-", "missing exponent digits"},
+		{"1e+x", "invalid exponent"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := NewFromString(tt.input)
+			if err == nil {
+				t.Fatalf("NewString(%q) expected an error", tt.input)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("NewString(%q) error = %q, want it to contain %q", tt.input, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromStringWithSource(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantSource string
+		wantVal    string
+		wantScale  int32
+		wantErr    bool
+	}{
+		{"123.45", "123.45", "12345", 2, false},
+		{"  123.45\t", "123.45", "12345", 2, false},
+		{"1_234_567", "1234567", "1234567", 0, false},
+		{" -1_234.5_6\n", "-1234.56", "-123456", 2, false},
+		{"1_000e-3", "1000e-3", "1000", 3, false},
+		{"   ", "", "", 0, true},
+		{"1 234", "1 234", "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, source, err := NewFromStringWithSource(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFromStringWithSource(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if source != tt.wantSource {
+				t.Errorf("NewFromStringWithSource(%q) source = %q, want %q", tt.input, source, tt.wantSource)
+			}
+			if !tt.wantErr {
+				if got.unscaledValue.String() != tt.wantVal {
+					t.Errorf("NewFromStringWithSource(%q) = %v, want %v", tt.input, got.unscaledValue, tt.wantVal)
+				}
+				if got.scale != tt.wantScale {
+					t.Errorf("NewFromStringWithSource(%q) scale = %v, want %v", tt.input, got.scale, tt.wantScale)
+				}
+			}
+		})
+	}
+}
+
+func TestMustFromString(t *testing.T) {
+	if got := MustFromString("-12.340"); got.unscaledValue.String() != "-12340" || got.scale != 3 {
+		t.Errorf("MustFromString() = %v scale %d, want -12340 scale 3", got.unscaledValue, got.scale)
+	}
+	for _, input := range []string{"", "1.2.3", "NaN"} {
+		t.Run(input, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected a panic but did not get one")
+				}
+			}()
+			MustFromString(input)
+		})
+	}
+}
+
+func TestMustFromRat(t *testing.T) {
+	if got := MustFromRat(big.NewRat(2, 3), 4, RoundHalfUp); got.String() != "0.6667" {
+		t.Errorf("MustFromRat() = %v, want 0.6667", got)
+	}
+	tests := []struct {
+		name string
+		r    *big.Rat
+		prec int32
+		mode RoundingMode
+	}{
+		{"nil", nil, 2, RoundHalfUp},
+		{"negative precision", big.NewRat(1, 2), -1, RoundHalfUp},
+		{"rounding necessary", big.NewRat(1, 3), 2, RoundUnnecessary},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected a panic but did not get one")
+				}
+			}()
+			MustFromRat(tt.r, tt.prec, tt.mode)
+		})
+	}
+}
+
 func TestNewFromFloat64(t *testing.T) {
 	tests := []struct {
 		input   float64
@@ -205,6 +429,140 @@ func TestNewFromFloat64(t *testing.T) {
 	}
 }
 
+func TestNewFromFloat64WithPrecision(t *testing.T) {
+	tests := []struct {
+		input     float64
+		precision int32
+		mode      RoundingMode
+		want      string
+		wantErr   bool
+	}{
+		{0.1, 2, RoundHalfUp, "0.10", false},
+		{0.1, 20, RoundHalfUp, "0.10000000000000000555", false},
+		{0.1, 64, RoundHalfEven, "0.1000000000000000055511151231257827021181583404541015625000000000", false},
+		{-2.675, 2, RoundHalfUp, "-2.67", false},
+		{2.5, 0, RoundDown, "2", false},
+		{0, 3, RoundHalfUp, "0.000", false},
+		{1e-300, 2, RoundHalfUp, "0.00", false},
+		{0.25, 2, RoundUnnecessary, "0.25", false},
+		{-0.001, 2, RoundFloor, "-0.01", false},
+		{0.001, 2, RoundCeiling, "0.01", false},
+		{0.1, 2, RoundUnnecessary, "", true},
+		{1.5, -1, RoundHalfUp, "", true},
+		{math.NaN(), 2, RoundHalfUp, "", true},
+		{math.Inf(-1), 2, RoundHalfUp, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%g_%d_%v", tt.input, tt.precision, tt.mode), func(t *testing.T) {
+			got, err := NewFromFloat64WithPrecision(tt.input, tt.precision, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFromFloat64WithPrecision(%v, %d, %v) error = %v, wantErr %v",
+					tt.input, tt.precision, tt.mode, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NewFromFloat64WithPrecision(%v, %d, %v) = %v, want %v",
+					tt.input, tt.precision, tt.mode, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromFloat64Default(t *testing.T) {
+	for _, input := range []float64{0.1, -123.45, 1e20} {
+		got, err := NewFromFloat64(input)
+		if err != nil {
+			t.Fatalf("NewFromFloat64(%v) unexpected error: %v", input, err)
+		}
+		want, _ := NewFromFloat64WithPrecision(input, 64, RoundHalfEven)
+		if !got.StrictEqual(want) {
+			t.Errorf("NewFromFloat64(%v) = %v, want %v", input, got, want)
+		}
+	}
+	if got, _ := NewFromFloat64(0); got.scale != 0 {
+		t.Errorf("NewFromFloat64(0) scale = %d, want 0", got.scale)
+	}
+}
+
+func TestNewFromBigFloat(t *testing.T) {
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	tests := []struct {
+		name      string
+		f         *big.Float
+		precision int32
+		mode      RoundingMode
+		want      string
+		wantErr   bool
+	}{
+		{"exact binary fraction", big.NewFloat(2.5), 1, RoundUnnecessary, "2.5", false},
+		{"padded", big.NewFloat(-0.375), 5, RoundHalfUp, "-0.37500", false},
+		{"rounded", big.NewFloat(0.1), 3, RoundHalfUp, "0.100", false},
+		{"float64 precision", big.NewFloat(0.1), 20, RoundHalfUp, "0.10000000000000000555", false},
+		{"24-bit precision", new(big.Float).SetPrec(24).SetFloat64(0.1), 12, RoundHalfUp, "0.100000001490", false},
+		{"200-bit third", third, 40, RoundHalfEven, "0.3333333333333333333333333333333333333333", false},
+		{"large integer", new(big.Float).SetInt(new(big.Int).Lsh(big.NewInt(1), 100)), 0, RoundUnnecessary,
+			"1267650600228229401496703205376", false},
+		{"zero", new(big.Float), 2, RoundHalfUp, "0.00", false},
+		{"nil", nil, 2, RoundHalfUp, "", true},
+		{"infinity", new(big.Float).SetInf(false), 2, RoundHalfUp, "", true},
+		{"negative infinity", new(big.Float).SetInf(true), 2, RoundHalfUp, "", true},
+		{"rounding necessary", big.NewFloat(0.1), 3, RoundUnnecessary, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFromBigFloat(tt.f, tt.precision, tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFromBigFloat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NewFromBigFloat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromFloat64Canonical(t *testing.T) {
+	tests := []struct {
+		input     float64
+		wantVal   string
+		wantScale int32
+		wantErr   bool
+	}{
+		{0.1, "100000000000000006", 18, false},
+		{1.5, "150000000000000000", 17, false},
+		{-1.5, "-150000000000000000", 17, false},
+		{1.0 / 3, "333333333333333315", 18, false},
+		{1e300, "100000000000000005", -283, false},
+		{0, "0", 17, false},
+		{math.NaN(), "", 0, true},
+		{math.Inf(1), "", 0, true},
+		{math.Inf(-1), "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%g", tt.input), func(t *testing.T) {
+			got, err := NewFromFloat64Canonical(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFromFloat64Canonical(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+				t.Errorf("NewFromFloat64Canonical(%v) = %v scale %d, want %v scale %d",
+					tt.input, got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+			}
+			again, err := NewFromFloat64Canonical(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromFloat64Canonical(%v) unexpected error: %v", tt.input, err)
+			}
+			first, _ := got.MarshalBinary()
+			second, _ := again.MarshalBinary()
+			if !bytes.Equal(first, second) {
+				t.Errorf("NewFromFloat64Canonical(%v) is not byte-identical across calls", tt.input)
+			}
+		})
+	}
+}
+
 func TestNewFromRat(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -221,6 +579,26 @@ func TestNewFromRat(t *testing.T) {
 		{"third", 1, 3, 2, RoundHalfEven, "33", 2, false},
 		{"third up", 1, 3, 2, RoundUp, "34", 2, false},
 		{"negative half", -1, 2, 1, RoundHalfEven, "-5", 1, false},
+		{"tie half ceiling", 1, 2, 0, RoundHalfCeiling, "1", 0, false},
+		{"negative tie half ceiling", -1, 2, 0, RoundHalfCeiling, "0", 0, false},
+		{"tie half floor", 3, 8, 2, RoundHalfFloor, "37", 2, false},
+		{"negative tie half floor", -3, 8, 2, RoundHalfFloor, "-38", 2, false},
+		{"above tie half floor", 2, 3, 0, RoundHalfFloor, "1", 0, false},
+		{"tie half odd rounds up", 5, 2, 0, RoundHalfOdd, "3", 0, false},
+		{"tie half odd kept", 3, 2, 0, RoundHalfOdd, "1", 0, false},
+		{"negative tie half odd", -5, 2, 0, RoundHalfOdd, "-3", 0, false},
+		{"negative tie half odd below one", -1, 2, 0, RoundHalfOdd, "-1", 0, false},
+		{"negative tie half up below one", -1, 2, 0, RoundHalfUp, "-1", 0, false},
+		{"tie half even kept", 5, 2, 0, RoundHalfEven, "2", 0, false},
+		{"tie half even moved", 7, 2, 0, RoundHalfEven, "4", 0, false},
+		{"tie half even at precision", 1, 8, 2, RoundHalfEven, "12", 2, false},
+		{"negative third ceiling", -1, 3, 0, RoundCeiling, "0", 0, false},
+		{"negative third floor", -1, 3, 0, RoundFloor, "-1", 0, false},
+		{"third ceiling", 1, 3, 0, RoundCeiling, "1", 0, false},
+		{"two thirds half down", 2, 3, 0, RoundHalfDown, "1", 0, false},
+		{"third half up", 1, 3, 0, RoundHalfUp, "0", 0, false},
+		{"one seventh at precision", 1, 7, 3, RoundHalfUp, "143", 3, false},
+		{"unsupported mode", 1, 3, 2, RoundingMode(99), "", 0, true},
 		{"zero", 0, 1, 0, RoundHalfEven, "0", 0, false},
 		{"nil rat", 0, 0, 0, RoundHalfEven, "", 0, true},
 		{"negative precision", 1, 1, -1, RoundHalfEven, "", 0, true},
@@ -286,6 +664,179 @@ func TestNewFromBytes(t *testing.T) {
 	}
 }
 
+func TestNewFromRunes(t *testing.T) {
+	tests := []struct {
+		input     []rune
+		wantVal   string
+		wantScale int32
+		wantErr   bool
+	}{
+		{[]rune("123.45"), "12345", 2, false},
+		{[]rune("-1.23e+2"), "-123", 0, false},
+		{[]rune(""), "", 0, true},
+		{[]rune("12a"), "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.input), func(t *testing.T) {
+			got, err := NewFromRunes(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewFromRunes(%q) error = %v, wantErr %v", string(tt.input), err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				if got.unscaledValue.String() != tt.wantVal {
+					t.Errorf("NewFromRunes(%q) = %v, want %v", string(tt.input), got.unscaledValue, tt.wantVal)
+				}
+				if got.scale != tt.wantScale {
+					t.Errorf("NewFromRunes(%q) scale = %v, want %v", string(tt.input), got.scale, tt.wantScale)
+				}
+			}
+		})
+	}
+}
+
+func TestNewFromPercentString(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"12.34%", "0.1234", false},
+		{"-5%", "-0.05", false},
+		{"150%", "1.50", false},
+		{"0.5%", "0.005", false},
+		{"1e+2%", "1", false},
+		{"12.5 %", "0.125", false},
+		{" 12.5% ", "0.125", false},
+		{"\t-12.5 %\n", "-0.125", false},
+		{"12.34", "", true},
+		{" % ", "", true},
+		{"12 .5%", "", true},
+		{"%", "", true},
+		{"abc%", "", true},
+		{"1e-2147483647%", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NewFromPercentString(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFromPercentString(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NewFromPercentString(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFromAccountingString(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"(123.45)", "-123.45", false},
+		{"123.45", "123.45", false},
+		{"-123.45", "-123.45", false},
+		{"(0.00)", "0.00", false},
+		{"(1,234.50)", "-1234.50", false},
+		{"1,234,567", "1234567", false},
+		{"-12,345.6", "-12345.6", false},
+		{"(1e+3)", "-1000", false},
+		{" (7.5)\t", "-7.5", false},
+		{"(123.45", "", true},
+		{"123.45)", "", true},
+		{"(", "", true},
+		{")(", "", true},
+		{"((1))", "", true},
+		{"1(2)", "", true},
+		{"(-123.45)", "", true},
+		{"(+1)", "", true},
+		{"()", "", true},
+		{"1,23", "", true},
+		{"1234,567", "", true},
+		{",123", "", true},
+		{"1.234,5", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NewFromAccountingString(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFromAccountingString(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("NewFromAccountingString(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccountingStringRoundTrip(t *testing.T) {
+	f := CurrencyFormat{Places: 2, GroupSep: ",", DecimalSep: ".", Accounting: true}
+	for _, input := range []Decimal{New(-123456, 2), New(98765432, 2), New(-5, 2)} {
+		t.Run(input.String(), func(t *testing.T) {
+			got, err := NewFromAccountingString(input.FormatCurrencyWith(f))
+			if err != nil {
+				t.Fatalf("NewFromAccountingString() unexpected error: %v", err)
+			}
+			if !got.StrictEqual(input) {
+				t.Errorf("NewFromAccountingString(FormatCurrencyWith()) = %v, want %v", got, input)
+			}
+		})
+	}
+}
+
+func TestPercentStringRoundTrip(t *testing.T) {
+	for _, input := range []Decimal{New(1234, 4), New(-5, 2), New(15, 1)} {
+		t.Run(input.String(), func(t *testing.T) {
+			got, err := NewFromPercentString(input.StringPercent(4))
+			if err != nil {
+				t.Fatalf("NewFromPercentString() unexpected error: %v", err)
+			}
+			if !got.Equal(input) {
+				t.Errorf("NewFromPercentString(StringPercent()) = %v, want %v", got, input)
+			}
+		})
+	}
+}
+
+func TestNewFromPow10(t *testing.T) {
+	tests := []struct {
+		n    int32
+		want string
+	}{
+		{3, "1000"},
+		{-3, "0.001"},
+		{0, "1"},
+		{1, "10"},
+		{-1, "0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := NewFromPow10(tt.n)
+			want, err := NewFromString(tt.want)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.want, err)
+			}
+			if !got.Equal(want) || got.String() != tt.want {
+				t.Errorf("NewFromPow10(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		})
+	}
+
+	if got := NewFromPow10(math.MaxInt32); got.scale != -math.MaxInt32 || got.unscaledValue.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("NewFromPow10(MaxInt32) = %v scale %d, want 1 scale %d", got.unscaledValue, got.scale, -math.MaxInt32)
+	}
+}
+
+func TestNewFromPow10Panic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	NewFromPow10(math.MinInt32)
+}
+
 func TestPow10(t *testing.T) {
 	tests := []struct {
 		input int32
@@ -325,6 +876,170 @@ func TestPow10Cache(t *testing.T) {
 	}
 }
 
+func TestPow10LargePowerIsNotCached(t *testing.T) {
+	powersOfTenMutex.RLock()
+	before := len(powersOfTen)
+	powersOfTenMutex.RUnlock()
+
+	n := int32(DefaultPow10CacheSize + 100000)
+	if got := numDigits(pow10(n)); got != int(n)+1 {
+		t.Errorf("pow10(%d) has %d digits, want %d", n, got, n+1)
+	}
+	if pow10(n) == pow10(n) {
+		t.Errorf("pow10(%d) returned a cached instance", n)
+	}
+
+	powersOfTenMutex.RLock()
+	defer powersOfTenMutex.RUnlock()
+	if len(powersOfTen) != before {
+		t.Errorf("pow10(%d) grew the cache from %d to %d entries", n, before, len(powersOfTen))
+	}
+	if _, ok := powersOfTen[n]; ok {
+		t.Errorf("pow10(%d) was stored in the cache", n)
+	}
+}
+
+func TestSetPow10CacheSize(t *testing.T) {
+	defer SetPow10CacheSize(DefaultPow10CacheSize)
+
+	pow10(100)
+	SetPow10CacheSize(50)
+	pow10(49)
+	pow10(60)
+	powersOfTenMutex.RLock()
+	_, has49 := powersOfTen[49]
+	_, has60 := powersOfTen[60]
+	_, has100 := powersOfTen[100]
+	powersOfTenMutex.RUnlock()
+	if !has49 || has60 || has100 {
+		t.Errorf("with size 50, cached 10^49 %t, 10^60 %t, 10^100 %t; want true, false, false", has49, has60, has100)
+	}
+
+	SetPow10CacheSize(0)
+	pow10(38)
+	pow10(39)
+	powersOfTenMutex.RLock()
+	defer powersOfTenMutex.RUnlock()
+	if _, ok := powersOfTen[38]; !ok {
+		t.Errorf("SetPow10CacheSize(0) stopped caching 10^38")
+	}
+	if _, ok := powersOfTen[39]; ok {
+		t.Errorf("SetPow10CacheSize(0) cached 10^39")
+	}
+}
+
+func TestDecimal_rescale(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Decimal
+		newScale int32
+		wantVal  string
+	}{
+		{"same scale", New(-15, 1), 1, "-15"},
+		{"scale up", New(-15, 1), 3, "-1500"},
+		{"-1.5 down 1", New(-15, 1), 0, "-1"},
+		{"-1.99 down 2", New(-199, 2), 0, "-1"},
+		{"-1.99 down 1", New(-199, 2), 1, "-19"},
+		{"-0.999 down 3", New(-999, 3), 0, "0"},
+		{"-12345.6789 down 4", New(-123456789, 4), 0, "-12345"},
+		{"-12345.6789 down 6", New(-123456789, 4), -2, "-123"},
+		{"1.99 down 2", New(199, 2), 0, "1"},
+		{"-1 to negative scale", New(-1, 0), -1, "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.rescale(tt.newScale)
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.newScale {
+				t.Errorf("rescale(%d) = %v scale %d, want %v scale %d",
+					tt.newScale, got.unscaledValue, got.scale, tt.wantVal, tt.newScale)
+			}
+		})
+	}
+}
+
+func TestDecimal_rescaleDoesNotMutatePow10Cache(t *testing.T) {
+	want := pow10(3).String()
+	New(-7, 0).rescale(3)
+	New(12345, 3).rescale(0)
+	if got := pow10(3).String(); got != want {
+		t.Errorf("pow10(3) = %v after rescale, want %v", got, want)
+	}
+}
+
+func TestDecimal_StripTrailingZeros(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantVal   string
+		wantScale int32
+	}{
+		{"1.2300", "123", 2},
+		{"-1.2300", "-123", 2},
+		{"0.00", "0", 0},
+		{"1500", "1500", 0},
+		{"1.5e+3", "15", -2},
+		{"1.000", "1", 0},
+		{"10.010", "1001", 2},
+		{"1.23", "123", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.StripTrailingZeros()
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+				t.Errorf("StripTrailingZeros() = %v scale %d, want %v scale %d", got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+			}
+			if !got.Equal(d) {
+				t.Errorf("StripTrailingZeros() changed the value of %s", tt.input)
+			}
+		})
+	}
+
+	if got := New(0, math.MaxInt32).StripTrailingZeros(); got.scale != 0 {
+		t.Errorf("StripTrailingZeros() of zero at scale MaxInt32 has scale %d, want 0", got.scale)
+	}
+}
+
+func TestDecimal_Normalize(t *testing.T) {
+	tests := []struct {
+		inputs    []string
+		wantVal   string
+		wantScale int32
+	}{
+		{[]string{"1.5", "1.50", "1.500000", "15e-1"}, "15", 1},
+		{[]string{"1500", "1.5e+3", "1500.00", "15e+2"}, "15", -2},
+		{[]string{"0", "0.000", "0e+5", "-0.0"}, "0", 0},
+		{[]string{"-2.0", "-2", "-0.2e+1"}, "-2", 0},
+		{[]string{"123", "123.000"}, "123", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.inputs[0], func(t *testing.T) {
+			for _, input := range tt.inputs {
+				d, err := NewFromString(input)
+				if err != nil {
+					t.Fatalf("NewFromString(%q) unexpected error: %v", input, err)
+				}
+				got := d.Normalize()
+				if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+					t.Errorf("Normalize(%s) = %v scale %d, want %v scale %d", input, got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+				}
+				if !got.IsCanonical() {
+					t.Errorf("Normalize(%s) is not canonical", input)
+				}
+				if !got.Equal(d) {
+					t.Errorf("Normalize(%s) changed the value", input)
+				}
+				wantCanonical := d.unscaledValue.String() == tt.wantVal && d.scale == tt.wantScale
+				if d.IsCanonical() != wantCanonical {
+					t.Errorf("IsCanonical(%s) = %t, want %t", input, d.IsCanonical(), wantCanonical)
+				}
+			}
+		})
+	}
+}
+
 func TestDecimal_Scan(t *testing.T) {
 	tests := []struct {
 		input     string
@@ -357,6 +1072,62 @@ func TestDecimal_Scan(t *testing.T) {
 	}
 }
 
+func TestDecimal_ScanNumeric(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   interface{}
+		want    string
+		wantErr bool
+	}{
+		{"int64", int64(-42), "-42", false},
+		{"int64 zero", int64(0), "0", false},
+		{"float64", 2.5, "2.5" + strings.Repeat("0", 63), false},
+		{"float64 inexact", 0.1, "0.1000000000000000055511151231257827021181583404541015625" + strings.Repeat("0", 9), false},
+		{"big.Int", new(big.Int).Lsh(big.NewInt(1), 70), "1180591620717411303424", false},
+		{"nil big.Int", (*big.Int)(nil), "", true},
+		{"float64 NaN", math.NaN(), "", true},
+		{"int", 42, "", true},
+		{"bool", true, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Decimal
+			err := d.Scan(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && d.String() != tt.want {
+				t.Errorf("Scan(%v) = %v, want %v", tt.input, d, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Value(t *testing.T) {
+	tests := []struct {
+		input Decimal
+		want  string
+	}{
+		{New(12345, 2), "123.45"},
+		{New(-1500, 3), "-1.500"},
+		{New(7, -2), "700"},
+		{Decimal{}, "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			// DefaultParameterConverter is what database/sql applies to query
+			// arguments, and it defers to driver.Valuer implementations.
+			got, err := driver.DefaultParameterConverter.ConvertValue(tt.input)
+			if err != nil {
+				t.Fatalf("ConvertValue() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ConvertValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDecimal_String(t *testing.T) {
 	tests := []struct {
 		input Decimal
@@ -367,6 +1138,13 @@ func TestDecimal_String(t *testing.T) {
 		{New(0, 0), "0"},
 		{New(100000, 2), "1000.00"},
 		{New(100000, -2), "10000000"},
+		{New(5, 2), "0.05"},
+		{New(-5, 2), "-0.05"},
+		{New(-12, 2), "-0.12"},
+		{New(123, 5), "0.00123"},
+		{New(0, 3), "0.000"},
+		{New(0, -2), "0"},
+		{New(-7, -3), "-7000"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
@@ -377,3 +1155,127 @@ func TestDecimal_String(t *testing.T) {
 		})
 	}
 }
+
+func TestDecimal_Append(t *testing.T) {
+	tests := []struct {
+		input Decimal
+		want  string
+	}{
+		{New(123, 0), "123"},
+		{New(-12345, 2), "-123.45"},
+		{New(100000, -2), "10000000"},
+		{New(-5, 2), "-0.05"},
+		{New(123, 5), "0.00123"},
+		{New(0, 3), "0.000"},
+		{Decimal{}, "<nil>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.input.Append([]byte("x="))
+			if string(got) != "x="+tt.want {
+				t.Errorf("Append(%q) = %q, want %q", "x=", got, "x="+tt.want)
+			}
+			if s := tt.input.String(); s != tt.want {
+				t.Errorf("String() = %q, want %q", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_AppendReusesBuffer(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	buf = New(-12345, 2).Append(buf)
+	buf = append(buf, ',')
+	buf = New(5, 3).Append(buf)
+	if got, want := string(buf), "-123.45,0.005"; got != want {
+		t.Errorf("Append into a shared buffer = %q, want %q", got, want)
+	}
+}
+
+func BenchmarkDecimal_String(b *testing.B) {
+	d := New(-123456789012345, 6)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkDecimal_Append(b *testing.B) {
+	d := New(-123456789012345, 6)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf = d.Append(buf[:0])
+	}
+}
+
+// zeroValueArg returns a harmless argument of type typ for
+// TestDecimal_ZeroValueMethods, or false if the type is unknown.
+func zeroValueArg(typ reflect.Type) (reflect.Value, bool) {
+	var arg any
+	switch typ {
+	case reflect.TypeOf(Decimal{}):
+		arg = New(15, 1)
+	case reflect.TypeOf([]Decimal{}):
+		arg = []Decimal{New(1, 0), New(2, 0)}
+	case reflect.TypeOf(RoundingMode(0)):
+		arg = RoundHalfUp
+	case reflect.TypeOf(CurrencyFormat{}):
+		arg = CurrencyFormat{Symbol: "$", Places: 2, SymbolBefore: true, GroupSep: ",", DecimalSep: "."}
+	case reflect.TypeOf(int32(0)):
+		arg = int32(2)
+	case reflect.TypeOf(0):
+		arg = 2
+	case reflect.TypeOf(uint(0)):
+		arg = uint(2)
+	case reflect.TypeOf(false):
+		arg = true
+	case reflect.TypeOf(""):
+		arg = "en"
+	case reflect.TypeOf([]int{}):
+		arg = []int{1, 1}
+	case reflect.TypeOf([]byte{}):
+		arg = []byte("1.5")
+	case reflect.TypeOf((*any)(nil)).Elem():
+		arg = "1.5"
+	case reflect.TypeOf((*io.Writer)(nil)).Elem():
+		arg = new(bytes.Buffer)
+	default:
+		return reflect.Value{}, false
+	}
+	return reflect.ValueOf(arg), true
+}
+
+func TestDecimal_ZeroValueMethods(t *testing.T) {
+	methods := reflect.TypeOf(&Decimal{})
+	for i := 0; i < methods.NumMethod(); i++ {
+		m := methods.Method(i)
+		if m.Name == "Format" {
+			// Needs a fmt.State; exercised through Sprintf below
+			continue
+		}
+		t.Run(m.Name, func(t *testing.T) {
+			args := []reflect.Value{reflect.ValueOf(&Decimal{})}
+			for j := 1; j < m.Type.NumIn(); j++ {
+				arg, ok := zeroValueArg(m.Type.In(j))
+				if !ok {
+					t.Fatalf("no test argument for parameter type %v", m.Type.In(j))
+				}
+				args = append(args, arg)
+			}
+			defer func() {
+				if r := recover(); r != nil {
+					t.Errorf("%s on the zero value panicked: %v", m.Name, r)
+				}
+			}()
+			m.Func.Call(args)
+		})
+	}
+
+	var zero Decimal
+	if got := fmt.Sprintf("%v %s %d %.2f %8.1f", zero, zero, zero, zero, zero); got != "0 0 0 0.00      0.0" {
+		t.Errorf("Sprintf on the zero value = %q", got)
+	}
+}
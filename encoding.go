@@ -0,0 +1,149 @@
+package decimal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// The Decimal is emitted as a JSON number using its String form, so the
+// scale (including trailing zeros) is preserved. A zero-value Decimal is
+// emitted as 0.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if d.unscaledValue == nil {
+		return []byte("0"), nil
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts JSON numbers as well as quoted strings, both parsed with
+// NewFromString, so "1.50", 1.50 and 1.5e2 are all valid. JSON null produces
+// a zero Decimal.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		d.unscaledValue = new(big.Int)
+		d.scale = 0
+		return nil
+	}
+
+	var parsedDecimal Decimal
+	var err error
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err = json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("failed to unmarshal JSON string to Decimal: %w", err)
+		}
+		parsedDecimal, err = NewFromString(s)
+	} else {
+		parsedDecimal, err = NewFromBytes(data)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal JSON to Decimal: %w", err)
+	}
+
+	*d = parsedDecimal
+	return nil
+}
+
+// InferScale parses jsonArray, a JSON array of numbers or quoted decimal
+// strings, and returns the largest number of fractional digits among its
+// elements, so a loader can choose a column scale: [1.5, 2.25, 3] gives 2.
+// Numbers are read exactly with NewFromString, never through float64, and
+// trailing zeros count ("1.50" has 2 fractional digits). Integers, exponent
+// forms without fractional digits and an empty array give 0.
+func InferScale(jsonArray []byte) (int32, error) {
+	var values []Decimal
+	if err := json.Unmarshal(jsonArray, &values); err != nil {
+		return 0, fmt.Errorf("failed to infer scale: %w", err)
+	}
+
+	var scale int32
+	for _, v := range values {
+		if v.scale > scale {
+			scale = v.scale
+		}
+	}
+	return scale, nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is the scale as a little-endian int32 followed by the
+// big.Int GobEncode form of the unscaled value, which is considerably more
+// compact than the decimal string for large coefficients.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	unscaledValue := d.unscaledValue
+	if unscaledValue == nil {
+		unscaledValue = new(big.Int)
+	}
+	valueBytes, err := unscaledValue.GobEncode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Decimal value: %w", err)
+	}
+
+	data := make([]byte, 4, 4+len(valueBytes))
+	binary.LittleEndian.PutUint32(data, uint32(d.scale))
+	return append(data, valueBytes...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface,
+// decoding the format produced by MarshalBinary.
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	if len(data) < 4 {
+		return fmt.Errorf("invalid Decimal binary encoding: need at least 4 bytes, got %d", len(data))
+	}
+
+	unscaledValue := new(big.Int)
+	if err := unscaledValue.GobDecode(data[4:]); err != nil {
+		return fmt.Errorf("failed to decode Decimal value: %w", err)
+	}
+
+	d.unscaledValue = unscaledValue
+	d.scale = int32(binary.LittleEndian.Uint32(data))
+	return nil
+}
+
+// gobVersion is the version of the format written by GobEncode.
+const gobVersion byte = 1
+
+// GobEncode implements the gob.GobEncoder interface.
+// The encoding is a version byte, the length of the MarshalBinary payload as
+// a little-endian uint32, and the payload itself. The explicit length lets
+// GobDecode detect fields appended by a newer version of the format.
+func (d Decimal) GobEncode() ([]byte, error) {
+	payload, err := d.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 5, 5+len(payload))
+	data[0] = gobVersion
+	binary.LittleEndian.PutUint32(data[1:], uint32(len(payload)))
+	return append(data, payload...), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface, decoding the format
+// produced by GobEncode. Data written with an unknown version, or carrying
+// fields this version does not understand, is rejected with an error.
+func (d *Decimal) GobDecode(data []byte) error {
+	if len(data) < 5 {
+		return fmt.Errorf("invalid Decimal gob encoding: need at least 5 bytes, got %d", len(data))
+	}
+	if data[0] != gobVersion {
+		return fmt.Errorf("unsupported Decimal gob encoding version %d (supported: %d)", data[0], gobVersion)
+	}
+
+	length := binary.LittleEndian.Uint32(data[1:])
+	payload := data[5:]
+	if uint64(len(payload)) < uint64(length) {
+		return fmt.Errorf("invalid Decimal gob encoding: payload truncated to %d of %d bytes", len(payload), length)
+	}
+	if extra := len(payload) - int(length); extra > 0 {
+		return fmt.Errorf("unsupported Decimal gob encoding version %d: %d unexpected trailing bytes", data[0], extra)
+	}
+
+	return d.UnmarshalBinary(payload)
+}
@@ -0,0 +1,288 @@
+package decimal
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestDecimal_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		input Decimal
+		want  string
+	}{
+		{New(12345, 2), "123.45"},
+		{New(-1500, 3), "-1.500"},
+		{New(0, 0), "0"},
+		{New(12, -3), "12000"},
+		{Decimal{}, "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("Marshal() unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantVal   string
+		wantScale int32
+		wantErr   bool
+	}{
+		{`123.45`, "12345", 2, false},
+		{`"123.45"`, "12345", 2, false},
+		{`1.500`, "1500", 3, false},
+		{`"1.500"`, "1500", 3, false},
+		{`1.5e+2`, "15", -1, false},
+		{`-2E-3`, "-2", 3, false},
+		{`null`, "0", 0, false},
+		{`"abc"`, "", 0, true},
+		{`true`, "", 0, true},
+		{`""`, "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var d Decimal
+			err := json.Unmarshal([]byte(tt.input), &d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal(%s) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				if d.unscaledValue.String() != tt.wantVal || d.scale != tt.wantScale {
+					t.Errorf("Unmarshal(%s) = %v scale %d, want %v scale %d",
+						tt.input, d.unscaledValue, d.scale, tt.wantVal, tt.wantScale)
+				}
+			}
+		})
+	}
+}
+
+func TestDecimal_JSONRoundTrip(t *testing.T) {
+	type payload struct {
+		Amount Decimal   `json:"amount"`
+		Fees   []Decimal `json:"fees"`
+	}
+	inputs := []string{
+		`{"amount":123.450,"fees":[0.10,2]}`,
+		`{"amount":1.23e+5,"fees":[-4.5E-2]}`,
+		`{"amount":"0.000","fees":[]}`,
+	}
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			var first payload
+			if err := json.Unmarshal([]byte(input), &first); err != nil {
+				t.Fatalf("Unmarshal() unexpected error: %v", err)
+			}
+			data, err := json.Marshal(first)
+			if err != nil {
+				t.Fatalf("Marshal() unexpected error: %v", err)
+			}
+			var second payload
+			if err := json.Unmarshal(data, &second); err != nil {
+				t.Fatalf("Unmarshal(%s) unexpected error: %v", data, err)
+			}
+			if !second.Amount.Equal(first.Amount) {
+				t.Errorf("round trip amount = %v, want %v", second.Amount, first.Amount)
+			}
+			// Non-negative scales, including trailing zeros, survive exactly
+			if first.Amount.scale >= 0 && !second.Amount.StrictEqual(first.Amount) {
+				t.Errorf("round trip amount = %v scale %d, want scale %d", second.Amount, second.Amount.scale, first.Amount.scale)
+			}
+			if len(second.Fees) != len(first.Fees) {
+				t.Fatalf("round trip fees = %v, want %v", second.Fees, first.Fees)
+			}
+			for i := range first.Fees {
+				if !second.Fees[i].StrictEqual(first.Fees[i]) {
+					t.Errorf("round trip fee %d = %v, want %v", i, second.Fees[i], first.Fees[i])
+				}
+			}
+		})
+	}
+}
+
+func TestInferScale(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int32
+		wantErr bool
+	}{
+		{`[1.5, 2.25, 3]`, 2, false},
+		{`["1.50", "2.125"]`, 3, false},
+		{`[0.1, 0.20000000000000000001]`, 20, false},
+		{`[1e+5, 7]`, 0, false},
+		{`[1.25e-3]`, 5, false},
+		{`[]`, 0, false},
+		{`[1.5, "abc"]`, 0, true},
+		{`{"a": 1}`, 0, true},
+		{`[1.5`, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := InferScale([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("InferScale() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("InferScale() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_BinaryRoundTrip(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-123456789012345678901234567890123456789012345678901234567890", 10)
+	tests := []struct {
+		name  string
+		input Decimal
+	}{
+		{"zero", New(0, 0)},
+		{"zero value", Decimal{}},
+		{"positive", New(12345, 2)},
+		{"negative", New(-12345, 2)},
+		{"negative scale", New(7, -12)},
+		{"max scale", New(1, math.MaxInt32)},
+		{"min scale", New(-1, math.MinInt32)},
+		{"huge", Decimal{unscaledValue: huge, scale: 30}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.input.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary() unexpected error: %v", err)
+			}
+			var got Decimal
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() unexpected error: %v", err)
+			}
+			want := tt.input
+			if want.unscaledValue == nil {
+				want = New(0, want.scale)
+			}
+			if !got.StrictEqual(want) {
+				t.Errorf("binary round trip = %v scale %d, want %v scale %d", got, got.scale, want, want.scale)
+			}
+		})
+	}
+}
+
+func TestDecimal_BinaryRoundTripRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		value := new(big.Int).Rand(rng, new(big.Int).Lsh(big.NewInt(1), uint(rng.Intn(512)+1)))
+		if rng.Intn(2) == 0 {
+			value.Neg(value)
+		}
+		input := Decimal{unscaledValue: value, scale: int32(rng.Uint32())}
+
+		data, err := input.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%v scale %d) unexpected error: %v", input.unscaledValue, input.scale, err)
+		}
+		var got Decimal
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary() unexpected error: %v", err)
+		}
+		if !got.StrictEqual(input) {
+			t.Fatalf("binary round trip = %v scale %d, want %v scale %d",
+				got.unscaledValue, got.scale, input.unscaledValue, input.scale)
+		}
+	}
+}
+
+func TestDecimal_UnmarshalBinaryInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"short", []byte{1, 0, 0}},
+		{"bad version", []byte{0, 0, 0, 0, 0xff, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Decimal
+			if err := d.UnmarshalBinary(tt.data); err == nil {
+				t.Errorf("UnmarshalBinary(%v) did not return an error", tt.data)
+			}
+		})
+	}
+}
+
+func TestDecimal_GobRoundTrip(t *testing.T) {
+	type record struct {
+		Name   string
+		Amount Decimal
+		Rates  []Decimal
+	}
+	huge, _ := new(big.Int).SetString("98765432109876543210987654321098765432109876543210", 10)
+	want := record{
+		Name:   "invoice",
+		Amount: New(-123450, 3),
+		Rates:  []Decimal{New(0, 2), New(7, -4), {unscaledValue: huge, scale: 25}},
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("Encode() unexpected error: %v", err)
+	}
+	var got record
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode() unexpected error: %v", err)
+	}
+
+	if got.Name != want.Name {
+		t.Errorf("Name = %q, want %q", got.Name, want.Name)
+	}
+	if !got.Amount.StrictEqual(want.Amount) {
+		t.Errorf("Amount = %v scale %d, want %v scale %d", got.Amount, got.Amount.scale, want.Amount, want.Amount.scale)
+	}
+	if len(got.Rates) != len(want.Rates) {
+		t.Fatalf("Rates = %v, want %v", got.Rates, want.Rates)
+	}
+	for i := range want.Rates {
+		if !got.Rates[i].StrictEqual(want.Rates[i]) {
+			t.Errorf("Rates[%d] = %v scale %d, want %v scale %d", i, got.Rates[i], got.Rates[i].scale, want.Rates[i], want.Rates[i].scale)
+		}
+	}
+}
+
+func TestDecimal_GobDecodeInvalid(t *testing.T) {
+	valid, err := New(12345, 2).GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode() unexpected error: %v", err)
+	}
+	newerVersion := append([]byte{}, valid...)
+	newerVersion[0] = gobVersion + 1
+	trailingField := append(append([]byte{}, valid...), 0x01, 0x02)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"short", valid[:3]},
+		{"truncated payload", valid[:len(valid)-1]},
+		{"newer version", newerVersion},
+		{"trailing field", trailingField},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Decimal
+			if err := d.GobDecode(tt.data); err == nil {
+				t.Errorf("GobDecode(%v) did not return an error", tt.data)
+			}
+		})
+	}
+}
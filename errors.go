@@ -0,0 +1,26 @@
+package decimal
+
+import "errors"
+
+// Sentinel errors wrapped by the package's errors, so callers can tell
+// failures apart with errors.Is instead of matching messages:
+//
+//	if _, err := a.Divide(b, 2, RoundHalfEven); errors.Is(err, ErrDivisionByZero) {
+//		...
+//	}
+var (
+	// ErrDivisionByZero is returned when a divisor is zero.
+	ErrDivisionByZero = errors.New("division by zero")
+
+	// ErrInvalidFormat is returned when a string does not hold a decimal
+	// number in a supported format.
+	ErrInvalidFormat = errors.New("invalid decimal format")
+
+	// ErrRoundingNecessary is returned when RoundUnnecessary is requested
+	// but the exact result has more digits than the requested precision.
+	ErrRoundingNecessary = errors.New("rounding necessary")
+
+	// ErrScaleOverflow is returned when a result's scale does not fit in
+	// an int32.
+	ErrScaleOverflow = errors.New("scale overflow")
+)
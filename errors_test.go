@@ -0,0 +1,92 @@
+package decimal
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	parse := func(s string) func() error {
+		return func() error {
+			_, err := NewFromString(s)
+			return err
+		}
+	}
+	tests := []struct {
+		name string
+		call func() error
+		want error
+	}{
+		{"divide by zero", func() error {
+			_, err := New(1, 0).Divide(New(0, 2), 2, RoundHalfEven)
+			return err
+		}, ErrDivisionByZero},
+		{"divide trim by zero", func() error {
+			_, err := New(1, 0).DivideTrim(Zero, 2, RoundHalfEven)
+			return err
+		}, ErrDivisionByZero},
+		{"floor div by zero", func() error {
+			_, err := New(1, 0).FloorDiv(Zero)
+			return err
+		}, ErrDivisionByZero},
+		{"root of zero", func() error {
+			_, err := Zero.Root(-2, 2, RoundHalfUp)
+			return err
+		}, ErrDivisionByZero},
+		{"empty string", parse(""), ErrInvalidFormat},
+		{"garbage", parse("12a"), ErrInvalidFormat},
+		{"two points", parse("1.2.3"), ErrInvalidFormat},
+		{"bad fraction", parse("1.2x"), ErrInvalidFormat},
+		{"missing exponent", parse("1e"), ErrInvalidFormat},
+		{"bad exponent", parse("1e+x"), ErrInvalidFormat},
+		{"nan", parse("NaN"), ErrInvalidFormat},
+		{"scale overflow", parse("1e-3000000000"), ErrScaleOverflow},
+		{"scale out of int64", parse("1e99999999999999999999"), ErrScaleOverflow},
+		{"percentage", func() error {
+			_, err := NewFromPercentString("abc%")
+			return err
+		}, ErrInvalidFormat},
+		{"accounting", func() error {
+			_, err := NewFromAccountingString("(1")
+			return err
+		}, ErrInvalidFormat},
+		{"scan", func() error {
+			var d Decimal
+			return d.Scan("1..2")
+		}, ErrInvalidFormat},
+		{"rat rounding necessary", func() error {
+			_, err := NewFromRat(big.NewRat(1, 3), 2, RoundUnnecessary)
+			return err
+		}, ErrRoundingNecessary},
+		{"divide rounding necessary", func() error {
+			_, err := New(1, 0).Divide(New(3, 0), 5, RoundUnnecessary)
+			return err
+		}, ErrRoundingNecessary},
+		{"product capped rounding necessary", func() error {
+			_, err := ProductCapped(1, RoundUnnecessary, New(15, 1), New(15, 1))
+			return err
+		}, ErrRoundingNecessary},
+		{"product capped scale overflow", func() error {
+			_, err := ProductCapped(2, RoundHalfEven, New(1, -2147483648), New(1, -1))
+			return err
+		}, ErrScaleOverflow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, tt.want) {
+				t.Errorf("error = %v, want errors.Is(err, %v)", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSentinelErrorsAreDistinct(t *testing.T) {
+	_, err := New(1, 0).Divide(Zero, 2, RoundHalfEven)
+	for _, other := range []error{ErrInvalidFormat, ErrRoundingNecessary, ErrScaleOverflow} {
+		if errors.Is(err, other) {
+			t.Errorf("division by zero error %v also matches %v", err, other)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package decimal
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// Runes returns the String form of d as a rune slice.
+func (d Decimal) Runes() []rune {
+	return []rune(d.String())
+}
+
+// WriteTo implements io.WriterTo, writing the String form of d to w without
+// allocating an intermediate string. It returns the number of bytes written
+// and any error from w.
+func (d Decimal) WriteTo(w io.Writer) (int64, error) {
+	var buf [32]byte
+	n, err := w.Write(d.Append(buf[:0]))
+	return int64(n), err
+}
+
+// StringFixed returns d rounded to places fractional digits with RoundHalfUp
+// and always printed with exactly that many, padding with zeros: 1.5 gives
+// "1.50" and 1.005 gives "1.01" at places 2. A negative places rounds to
+// tens, hundreds and so on, and prints an integer.
+func (d Decimal) StringFixed(places int32) string {
+	return d.Round(places, RoundHalfUp).String()
+}
+
+// StringFixedBank is like StringFixed but rounds with RoundHalfEven, as
+// accounting output usually requires.
+func (d Decimal) StringFixedBank(places int32) string {
+	return d.Round(places, RoundHalfEven).String()
+}
+
+// StringGrouped returns d rounded to places fractional digits with
+// RoundHalfUp, with the integer digits grouped in threes by groupSep and the
+// fractional part introduced by decimalSep: 1234567.891 with (",", ".", 2)
+// gives "1,234,567.89", and with (".", ",", 2) the European "1.234.567,89".
+// A minus sign precedes the first group.
+func (d Decimal) StringGrouped(groupSep, decimalSep string, places int32) string {
+	sign, intPart, fracPart := splitFixed(d, places)
+	s := sign + groupDigits(intPart, groupSep)
+	if fracPart != "" {
+		s += decimalSep + fracPart
+	}
+	return s
+}
+
+// CurrencyFormat describes how FormatCurrencyWith renders a money amount.
+type CurrencyFormat struct {
+	// Symbol is the currency symbol, such as "$" or "€".
+	Symbol string
+	// Places is the number of minor-unit digits, e.g. 2 for cents.
+	Places int32
+	// SymbolBefore writes the symbol before the amount with no space
+	// ("$1.50"); otherwise it follows the amount after a space ("1,50 €").
+	SymbolBefore bool
+	// GroupSep separates groups of three integer digits.
+	GroupSep string
+	// DecimalSep introduces the minor units.
+	DecimalSep string
+	// Accounting wraps negative amounts in parentheses, "($1.50)", instead
+	// of writing a leading minus sign, "-$1.50".
+	Accounting bool
+}
+
+// FormatCurrency renders d as a money amount rounded with RoundHalfUp to
+// places minor-unit digits. A symbol placed before the amount uses the
+// English separators, "$1,234.50"; one placed after uses the continental
+// European separators, "1.234,50 €". Negative amounts start with a minus
+// sign: "-$1,234.50", "-1.234,50 €". Use FormatCurrencyWith for other
+// separators or accounting-style parentheses.
+func (d Decimal) FormatCurrency(symbol string, places int32, symbolBefore bool) string {
+	f := CurrencyFormat{Symbol: symbol, Places: places, SymbolBefore: symbolBefore, GroupSep: ",", DecimalSep: "."}
+	if !symbolBefore {
+		f.GroupSep, f.DecimalSep = ".", ","
+	}
+	return d.FormatCurrencyWith(f)
+}
+
+// FormatCurrencyWith renders d as a money amount following f. The amount is
+// rounded with RoundHalfUp to f.Places minor-unit digits and grouped like
+// StringGrouped.
+func (d Decimal) FormatCurrencyWith(f CurrencyFormat) string {
+	sign, intPart, fracPart := splitFixed(d, f.Places)
+	amount := groupDigits(intPart, f.GroupSep)
+	if fracPart != "" {
+		amount += f.DecimalSep + fracPart
+	}
+
+	if f.SymbolBefore {
+		amount = f.Symbol + amount
+	} else if f.Symbol != "" {
+		amount = amount + " " + f.Symbol
+	}
+
+	switch {
+	case sign == "":
+		return amount
+	case f.Accounting:
+		return "(" + amount + ")"
+	default:
+		return sign + amount
+	}
+}
+
+// splitFixed rounds d to places fractional digits with RoundHalfUp and
+// returns its sign ("" or "-"), integer digits and fractional digits.
+func splitFixed(d Decimal, places int32) (sign, intPart, fracPart string) {
+	s := d.StringFixed(places)
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, fracPart, _ = strings.Cut(s, ".")
+	return sign, intPart, fracPart
+}
+
+// groupDigits inserts sep between every group of three digits of the
+// unsigned integer string digits, counting from the right.
+func groupDigits(digits, sep string) string {
+	if len(digits) <= 3 || sep == "" {
+		return digits
+	}
+	var b strings.Builder
+	head := len(digits) % 3
+	if head == 0 {
+		head = 3
+	}
+	b.WriteString(digits[:head])
+	for i := head; i < len(digits); i += 3 {
+		b.WriteString(sep)
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
+
+// StringScientific returns d in normalized scientific notation m.mmmE±x, with
+// one non-zero digit before the point and significantDigits digits in total,
+// the mantissa rounded with mode: 123000 with 3 digits gives "1.23E+5" and
+// 0.000123 gives "1.23E-4". The mantissa keeps trailing zeros, so 1.5 with 3
+// digits gives "1.50E+0", and zero gives "0.00E+0". A significantDigits below
+// 1 is treated as 1. Like Round, mode RoundUnnecessary panics if digits would
+// be lost.
+func (d Decimal) StringScientific(significantDigits int32, mode RoundingMode) string {
+	sign, digits, exponent := d.significand(significantDigits, mode)
+	return sign + insertPoint(digits, 1) + fmt.Sprintf("E%+d", exponent)
+}
+
+// StringEngineering returns d in engineering notation, like StringScientific
+// but with an exponent that is a multiple of three so that the mantissa lies
+// in [1, 1000) and matches SI prefixes: 12345 with 5 significant digits gives
+// "12.345E+3" and 0.001 with 1 gives "1E-3". The mantissa is rounded with
+// RoundHalfUp to significantDigits digits (at least 1); when that leaves
+// fewer digits than the integer part needs, it is padded with zeros, so 12345
+// with 1 digit gives "10E+3".
+func (d Decimal) StringEngineering(significantDigits int32) string {
+	sign, digits, exponent := d.significand(significantDigits, RoundHalfUp)
+	intDigits := int(exponent%3+3)%3 + 1
+	if len(digits) < intDigits {
+		digits += strings.Repeat("0", intDigits-len(digits))
+	}
+	return sign + insertPoint(digits, intDigits) + fmt.Sprintf("E%+d", exponent-int64(intDigits-1))
+}
+
+// significand rounds d to significantDigits significant digits (at least 1)
+// with mode and returns its sign ("" or "-"), exactly that many digits and
+// the exponent of the first one, so that d ≈ sign 0.digits × 10^(exponent+1).
+func (d Decimal) significand(significantDigits int32, mode RoundingMode) (sign, digits string, exponent int64) {
+	significantDigits = max(significantDigits, 1)
+	if d.IsZero() {
+		return "", strings.Repeat("0", int(significantDigits)), 0
+	}
+
+	exponent = int64(numDigits(d.unscaledValue)) - 1 - int64(d.scale)
+	rounded := d.Round(int32(int64(significantDigits)-1-exponent), mode)
+	digits = rounded.unscaledValue.String()
+	if rounded.unscaledValue.Sign() < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if len(digits) > int(significantDigits) {
+		// Rounding carried into a new digit (9.99 -> 10.0); the extra
+		// digit is a trailing zero
+		digits = digits[:significantDigits]
+		exponent++
+	}
+	return sign, digits, exponent
+}
+
+// insertPoint places a decimal point after the first n digits, omitting it
+// when nothing follows.
+func insertPoint(digits string, n int) string {
+	if len(digits) <= n {
+		return digits
+	}
+	return digits[:n] + "." + digits[n:]
+}
+
+// Format implements fmt.Formatter so that Decimals print like the built-in
+// numeric types:
+//
+//	%v, %s  the String form
+//	%f, %F  with a precision, d rounded to that many fractional digits with
+//	        RoundHalfUp (%.2f of 1.005 is "1.01"); without one, every digit
+//	        of d exactly as String prints it
+//	%d      the integer part, truncated toward zero
+//
+// The '+' and ' ' flags force a leading sign or space for non-negative
+// values, and a width pads with spaces on the left, on the right with '-',
+// or with zeros after the sign with '0'. Other verbs print as
+// "%!x(decimal.Decimal=1.5)", like fmt does for unsupported verbs.
+// The zero value Decimal{} formats as 0 under every verb.
+func (d Decimal) Format(f fmt.State, verb rune) {
+	d = d.orZero()
+	var s string
+	switch verb {
+	case 'v', 's':
+		s = d.String()
+	case 'f', 'F':
+		s = d.String()
+		if places, ok := f.Precision(); ok {
+			s = d.StringFixed(int32(places))
+		}
+	case 'd':
+		s = d.BigInt().String()
+	default:
+		fmt.Fprintf(f, "%%!%c(decimal.Decimal=%s)", verb, d.String())
+		return
+	}
+
+	sign := ""
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = "-", s[1:]
+	case f.Flag('+'):
+		sign = "+"
+	case f.Flag(' '):
+		sign = " "
+	}
+
+	padding := ""
+	if width, ok := f.Width(); ok && width > len(sign)+len(s) {
+		padding = strings.Repeat(" ", width-len(sign)-len(s))
+		switch {
+		case f.Flag('-'):
+			s += padding
+			padding = ""
+		case f.Flag('0'):
+			s = strings.Repeat("0", len(padding)) + s
+			padding = ""
+		}
+	}
+	io.WriteString(f, padding+sign+s)
+}
+
+// StringPercent returns the ratio d as a percentage rounded with RoundHalfUp
+// to places fractional digits and followed by "%": 0.1234 gives "12.34%" at
+// places 2, -0.05 gives "-5.0%" at places 1 and 1.5 gives "150%" at places 0.
+// NewFromPercentString parses the result back.
+func (d Decimal) StringPercent(places int32) string {
+	return d.Shift(2).StringFixed(places) + "%"
+}
+
+// StringZeroPadded formats d for fixed-width records such as COBOL copybooks
+// or ISO 8583 fields: exactly intDigits integer digits followed by exactly
+// fracDigits fractional digits, both zero-padded. With withPoint false the
+// decimal point is implied and not written, so 123.4 with (6, 2) gives
+// "00012340"; with withPoint true a "." separates the two parts and the
+// result is one character wider ("000123.40"). A fracDigits of 0 leaves
+// nothing to separate, so no point is written either way.
+// The value is never rounded: it returns an error if d has non-zero digits
+// beyond fracDigits, if the integer part needs more than intDigits digits, or
+// if d is negative, since such fields carry the sign separately.
+func (d Decimal) StringZeroPadded(intDigits, fracDigits int, withPoint bool) (string, error) {
+	if intDigits < 0 || fracDigits < 0 || fracDigits > math.MaxInt32 {
+		return "", fmt.Errorf("invalid digit counts %d and %d", intDigits, fracDigits)
+	}
+	if d.Sign() < 0 {
+		return "", fmt.Errorf("value %s is negative", d)
+	}
+
+	fixed := d.Round(int32(fracDigits), RoundDown)
+	if fixed.Cmp(d) != 0 {
+		return "", fmt.Errorf("value %s has more than %d fractional digits", d, fracDigits)
+	}
+	digits := fixed.unscaledValue.String()
+
+	width := intDigits + fracDigits
+	if len(digits) > width {
+		return "", fmt.Errorf("value %s does not fit in %d integer digits", d, intDigits)
+	}
+	digits = strings.Repeat("0", width-len(digits)) + digits
+	if withPoint && fracDigits > 0 {
+		digits = digits[:intDigits] + "." + digits[intDigits:]
+	}
+	return digits, nil
+}
@@ -0,0 +1,374 @@
+package decimal
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestDecimal_WriteTo(t *testing.T) {
+	tests := []Decimal{
+		New(123, 0),
+		New(-12345, 2),
+		New(5, 7),
+		New(7, -3),
+		MustFromString("-123456789012345678901234567890.123456789012345678901234567890"),
+		{},
+	}
+	for _, d := range tests {
+		t.Run(d.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			buf.WriteString("x=")
+			n, err := d.WriteTo(&buf)
+			if err != nil {
+				t.Fatalf("WriteTo unexpected error: %v", err)
+			}
+			if want := d.String(); buf.String() != "x="+want || n != int64(len(want)) {
+				t.Errorf("WriteTo wrote %q (%d bytes), want %q (%d bytes)", buf.String(), n, "x="+want, len(want))
+			}
+		})
+	}
+}
+
+// failingWriter accepts limit bytes and then fails.
+type failingWriter struct{ limit int }
+
+var errWriteFailed = errors.New("write failed")
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		return w.limit, errWriteFailed
+	}
+	return len(p), nil
+}
+
+func TestDecimal_WriteToError(t *testing.T) {
+	var _ io.WriterTo = Decimal{}
+	n, err := New(-12345, 2).WriteTo(&failingWriter{limit: 3})
+	if !errors.Is(err, errWriteFailed) || n != 3 {
+		t.Errorf("WriteTo = (%d, %v), want (3, %v)", n, err, errWriteFailed)
+	}
+}
+
+func TestDecimal_StringFixed(t *testing.T) {
+	tests := []struct {
+		input    string
+		places   int32
+		want     string
+		wantBank string
+	}{
+		{"1.5", 2, "1.50", "1.50"},
+		{"1.0050", 2, "1.01", "1.00"},
+		{"1.015", 2, "1.02", "1.02"},
+		{"1.005", 2, "1.01", "1.00"},
+		{"1.025", 2, "1.03", "1.02"},
+		{"-1.025", 2, "-1.03", "-1.02"},
+		{"1.0250", 2, "1.03", "1.02"},
+		{"-1.0050", 2, "-1.01", "-1.00"},
+		{"123.456", 0, "123", "123"},
+		{"0", 3, "0.000", "0.000"},
+		{"1.2e+3", 1, "1200.0", "1200.0"},
+		{"1250", -2, "1300", "1200"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := d.StringFixed(tt.places); got != tt.want {
+				t.Errorf("StringFixed(%d) = %q, want %q", tt.places, got, tt.want)
+			}
+			if got := d.StringFixedBank(tt.places); got != tt.wantBank {
+				t.Errorf("StringFixedBank(%d) = %q, want %q", tt.places, got, tt.wantBank)
+			}
+		})
+	}
+}
+
+func TestDecimal_StringGrouped(t *testing.T) {
+	tests := []struct {
+		input      string
+		groupSep   string
+		decimalSep string
+		places     int32
+		want       string
+	}{
+		{"1234567.891", ",", ".", 2, "1,234,567.89"},
+		{"1234567.891", ".", ",", 2, "1.234.567,89"},
+		{"-1234567.891", ".", ",", 2, "-1.234.567,89"},
+		{"-123456.5", ",", ".", 0, "-123,457"},
+		{"12.5", ",", ".", 2, "12.50"},
+		{"-7", ",", ".", 1, "-7.0"},
+		{"999.995", ",", ".", 2, "1,000.00"},
+		{"0", " ", ",", 2, "0,00"},
+		{"1234567", "'", ".", 0, "1'234'567"},
+		{"1.5e+6", "\u00a0", ",", 0, "1\u00a0500\u00a0000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := d.StringGrouped(tt.groupSep, tt.decimalSep, tt.places); got != tt.want {
+				t.Errorf("StringGrouped(%q, %q, %d) = %q, want %q", tt.groupSep, tt.decimalSep, tt.places, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_FormatCurrency(t *testing.T) {
+	tests := []struct {
+		input        string
+		symbol       string
+		places       int32
+		symbolBefore bool
+		want         string
+	}{
+		{"1234.5", "$", 2, true, "$1,234.50"},
+		{"1234.5", "€", 2, false, "1.234,50 €"},
+		{"-1234.5", "$", 2, true, "-$1,234.50"},
+		{"-1234.5", "€", 2, false, "-1.234,50 €"},
+		{"0.005", "$", 2, true, "$0.01"},
+		{"1234567.891", "¥", 0, true, "¥1,234,568"},
+		{"12.3456", "BTC", 4, false, "12,3456 BTC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := d.FormatCurrency(tt.symbol, tt.places, tt.symbolBefore); got != tt.want {
+				t.Errorf("FormatCurrency(%q, %d, %t) = %q, want %q", tt.symbol, tt.places, tt.symbolBefore, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_FormatCurrencyWith(t *testing.T) {
+	usd := CurrencyFormat{Symbol: "$", Places: 2, SymbolBefore: true, GroupSep: ",", DecimalSep: ".", Accounting: true}
+	chf := CurrencyFormat{Symbol: "CHF", Places: 2, GroupSep: "'", DecimalSep: ".", Accounting: true}
+	tests := []struct {
+		input  string
+		format CurrencyFormat
+		want   string
+	}{
+		{"-1234.5", usd, "($1,234.50)"},
+		{"1234.5", usd, "$1,234.50"},
+		{"-0.001", usd, "$0.00"},
+		{"-98765.4", chf, "(98'765.40 CHF)"},
+		{"5", CurrencyFormat{Places: 2, DecimalSep: "."}, "5.00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := d.FormatCurrencyWith(tt.format); got != tt.want {
+				t.Errorf("FormatCurrencyWith(%+v) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_StringScientific(t *testing.T) {
+	tests := []struct {
+		input  string
+		digits int32
+		mode   RoundingMode
+		want   string
+	}{
+		{"123000", 3, RoundHalfUp, "1.23E+5"},
+		{"0.000123", 3, RoundHalfUp, "1.23E-4"},
+		{"-123456", 3, RoundHalfUp, "-1.23E+5"},
+		{"123456", 3, RoundUp, "1.24E+5"},
+		{"-123456", 3, RoundFloor, "-1.24E+5"},
+		{"9.99", 2, RoundHalfUp, "1.0E+1"},
+		{"1.5", 3, RoundHalfUp, "1.50E+0"},
+		{"7", 1, RoundHalfUp, "7E+0"},
+		{"0", 3, RoundHalfUp, "0.00E+0"},
+		{"1.2e+100", 2, RoundHalfUp, "1.2E+100"},
+		{"12345", 0, RoundDown, "1E+4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.StringScientific(tt.digits, tt.mode)
+			if got != tt.want {
+				t.Errorf("StringScientific(%d, %s) = %q, want %q", tt.digits, tt.mode, got, tt.want)
+			}
+			parsed, err := NewFromString(got)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) cannot parse StringScientific output: %v", got, err)
+			}
+			if d.IsZero() || tt.digits < 1 {
+				return
+			}
+			if want := d.Round(int32(int64(tt.digits)-1-d.OrderOfMagnitude()), tt.mode); !parsed.Equal(want) {
+				t.Errorf("StringScientific output %q parses to %v, want %v", got, parsed, want)
+			}
+		})
+	}
+}
+
+func TestDecimal_StringEngineering(t *testing.T) {
+	tests := []struct {
+		input  string
+		digits int32
+		want   string
+	}{
+		{"12345", 5, "12.345E+3"},
+		{"1000", 1, "1E+3"},
+		{"999.9", 3, "1.00E+3"},
+		{"999", 3, "999E+0"},
+		{"0.001", 1, "1E-3"},
+		{"0.0009999", 2, "1.0E-3"},
+		{"0.01", 2, "10E-3"},
+		{"0.1234", 4, "123.4E-3"},
+		{"-12345", 3, "-12.3E+3"},
+		{"12345", 1, "10E+3"},
+		{"123456", 2, "120E+3"},
+		{"0", 2, "0.0E+0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := d.StringEngineering(tt.digits); got != tt.want {
+				t.Errorf("StringEngineering(%d) = %q, want %q", tt.digits, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		input  Decimal
+		want   string
+	}{
+		{"%v", New(15, 1), "1.5"},
+		{"%s", New(-150, 2), "-1.50"},
+		{"%f", New(12345, 3), "12.345"},
+		{"%.2f", New(1005, 3), "1.01"},
+		{"%.3f", New(15, 1), "1.500"},
+		{"%.0f", New(25, 1), "3"},
+		{"%F", New(12, -2), "1200"},
+		{"%d", New(-199, 2), "-1"},
+		{"%d", New(42, 0), "42"},
+		{"%+.1f", New(15, 1), "+1.5"},
+		{"%+d", New(-7, 0), "-7"},
+		{"% v", New(15, 1), " 1.5"},
+		{"%8.2f", New(15, 1), "    1.50"},
+		{"%-8.2f|", New(15, 1), "1.50    |"},
+		{"%08.2f", New(-15, 1), "-0001.50"},
+		{"%+08.2f", New(15, 1), "+0001.50"},
+		{"%3v", New(12345, 2), "123.45"},
+		{"%x", New(15, 1), "%!x(decimal.Decimal=1.5)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.input); got != tt.want {
+				t.Errorf("Sprintf(%q, %s) = %q, want %q", tt.format, tt.input.String(), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_StringPercent(t *testing.T) {
+	tests := []struct {
+		input  string
+		places int32
+		want   string
+	}{
+		{"0.1234", 2, "12.34%"},
+		{"0.1234", 1, "12.3%"},
+		{"0.12345", 2, "12.35%"},
+		{"-0.05", 1, "-5.0%"},
+		{"1.5", 0, "150%"},
+		{"0", 2, "0.00%"},
+		{"2e+1", 0, "2000%"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			if got := d.StringPercent(tt.places); got != tt.want {
+				t.Errorf("StringPercent(%d) = %q, want %q", tt.places, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_StringZeroPadded(t *testing.T) {
+	tests := []struct {
+		input      string
+		intDigits  int
+		fracDigits int
+		withPoint  bool
+		want       string
+		wantErr    bool
+	}{
+		{"123.4", 6, 2, false, "00012340", false},
+		{"123.4", 6, 2, true, "000123.40", false},
+		{"123.45", 6, 2, false, "00012345", false},
+		{"0", 6, 2, false, "00000000", false},
+		{"0", 6, 2, true, "000000.00", false},
+		{"999999.99", 6, 2, false, "99999999", false},
+		{"1.500", 6, 2, false, "00000150", false},
+		{"12", 2, 0, false, "12", false},
+		{"12", 2, 0, true, "12", false},
+		{"1e+3", 6, 0, true, "001000", false},
+		{"0.25", 0, 2, true, ".25", false},
+		{"1e+3", 4, 0, false, "1000", false},
+		{"1234567", 6, 2, false, "", true},
+		{"1.005", 6, 2, false, "", true},
+		{"-42.5", 6, 2, false, "", true},
+		{"1", -1, 2, false, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got, err := d.StringZeroPadded(tt.intDigits, tt.fracDigits, tt.withPoint)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StringZeroPadded(%d, %d, %t) error = %v, wantErr %v", tt.intDigits, tt.fracDigits, tt.withPoint, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("StringZeroPadded(%d, %d, %t) = %q, want %q", tt.intDigits, tt.fracDigits, tt.withPoint, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_RunesRoundTrip(t *testing.T) {
+	for _, input := range []Decimal{New(12345, 2), New(-5, 3), New(0, 0), New(7, -2)} {
+		t.Run(input.String(), func(t *testing.T) {
+			runes := input.Runes()
+			if string(runes) != input.String() {
+				t.Errorf("Runes() = %q, want %q", string(runes), input.String())
+			}
+			got, err := NewFromRunes(runes)
+			if err != nil {
+				t.Fatalf("NewFromRunes(%q) unexpected error: %v", string(runes), err)
+			}
+			if !got.Equal(input) {
+				t.Errorf("NewFromRunes(Runes()) = %v, want %v", got, input)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// AddInPlace sets d to d + other, with the same value and scale as
+// d.Add(other), but reuses d's coefficient instead of allocating a new one,
+// for accumulation loops.
+//
+// The in-place methods are the only exception to the package's immutability
+// guarantee: any Decimal sharing d's big.Int changes along with d. Results
+// of arithmetic, rounding and parsing have a coefficient of their own, but a
+// plain struct copy shares it, and so do the results of Shift, Abs, NegIf,
+// NormalizeExponent, StripTrailingZeros, Normalize, Clamp, NearestIn,
+// Distinct and UnifyScale, which may return their input. The package values
+// Zero, One, Ten and Hundred are copied before being modified and never
+// change. Use these methods only on a Decimal the caller owns exclusively,
+// such as the zero value or a Copy:
+//
+//	var total decimal.Decimal
+//	for _, line := range lines {
+//		total.AddInPlace(line.Amount)
+//	}
+func (d *Decimal) AddInPlace(other Decimal) {
+	d.addInPlace(other, false)
+}
+
+// SubInPlace sets d to d - other, with the same value and scale as d.Sub(other),
+// reusing d's coefficient. Like AddInPlace it breaks immutability and must not
+// be used on a shared Decimal.
+func (d *Decimal) SubInPlace(other Decimal) {
+	d.addInPlace(other, true)
+}
+
+// MulInPlace sets d to d * other, with the same value and scale as
+// d.Multiply(other), reusing d's coefficient. Like AddInPlace it breaks
+// immutability and must not be used on a shared Decimal. It panics like
+// Multiply if the product's scale overflows int32, leaving d unchanged.
+func (d *Decimal) MulInPlace(other Decimal) {
+	other = other.orZero()
+	scale, err := productScale(d.scale, other.scale)
+	if err != nil {
+		panic(fmt.Sprintf("MulInPlace: %v", err))
+	}
+	d.ownCoefficient()
+	d.unscaledValue.Mul(d.unscaledValue, other.unscaledValue)
+	d.scale = scale
+}
+
+// addInPlace aligns d and other to the larger scale, then adds or subtracts
+// other's coefficient into d's.
+func (d *Decimal) addInPlace(other Decimal, subtract bool) {
+	other = other.orZero()
+	d.ownCoefficient()
+	if other.unscaledValue == d.unscaledValue {
+		// Rescaling d below would also rescale other
+		other = other.Copy()
+	}
+	switch {
+	case d.scale < other.scale:
+		d.unscaledValue.Mul(d.unscaledValue, pow10(other.scale-d.scale))
+		d.scale = other.scale
+	case d.scale > other.scale:
+		other = other.rescale(d.scale)
+	}
+	if subtract {
+		d.unscaledValue.Sub(d.unscaledValue, other.unscaledValue)
+	} else {
+		d.unscaledValue.Add(d.unscaledValue, other.unscaledValue)
+	}
+}
+
+// ownCoefficient gives the zero value Decimal{} a coefficient of its own to
+// modify, and copies the coefficient of a package value such as One so that
+// no in-place call can change it.
+func (d *Decimal) ownCoefficient() {
+	switch d.unscaledValue {
+	case nil:
+		d.unscaledValue = new(big.Int)
+	case Zero.unscaledValue, One.unscaledValue, Ten.unscaledValue, Hundred.unscaledValue:
+		d.unscaledValue = new(big.Int).Set(d.unscaledValue)
+	}
+}
@@ -0,0 +1,190 @@
+package decimal
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDecimal_InPlace(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       Decimal
+		b       Decimal
+		wantAdd string
+		wantSub string
+		wantMul string
+	}{
+		{"same scale", New(125, 2), New(275, 2), "4.00", "-1.50", "3.4375"},
+		{"receiver rescaled", New(15, 1), New(125, 3), "1.625", "1.375", "0.1875"},
+		{"other rescaled", New(125, 3), New(15, 1), "1.625", "-1.375", "0.1875"},
+		{"negative scale", New(1, -2), New(5, 1), "100.5", "99.5", "50"},
+		{"zero value receiver", Decimal{}, New(25, 1), "2.5", "-2.5", "0.0"},
+		{"zero value other", New(25, 1), Decimal{}, "2.5", "2.5", "0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, op := range []struct {
+				name    string
+				inPlace func(*Decimal, Decimal)
+				want    Decimal
+				wantStr string
+			}{
+				{"AddInPlace", (*Decimal).AddInPlace, tt.a.Add(tt.b), tt.wantAdd},
+				{"SubInPlace", (*Decimal).SubInPlace, tt.a.Sub(tt.b), tt.wantSub},
+				{"MulInPlace", (*Decimal).MulInPlace, tt.a.Multiply(tt.b), tt.wantMul},
+			} {
+				got := tt.a.Copy()
+				if tt.a.unscaledValue == nil {
+					got = Decimal{}
+				}
+				op.inPlace(&got, tt.b)
+				if got.String() != op.wantStr || !got.StrictEqual(op.want) {
+					t.Errorf("%s = %v, want %v", op.name, got, op.wantStr)
+				}
+			}
+		})
+	}
+}
+
+func TestDecimal_InPlaceReusesCoefficient(t *testing.T) {
+	d := New(100, 2)
+	coefficient := d.unscaledValue
+	d.AddInPlace(New(5, 2))
+	d.SubInPlace(New(1, 0))
+	d.MulInPlace(New(3, 0))
+	if d.unscaledValue != coefficient {
+		t.Errorf("in-place operations replaced the receiver's coefficient")
+	}
+	if got := d.String(); got != "0.15" {
+		t.Errorf("d = %v, want 0.15", got)
+	}
+}
+
+func TestDecimal_InPlaceSelf(t *testing.T) {
+	d := New(15, 1)
+	d.AddInPlace(d)
+	if got := d.String(); got != "3.0" {
+		t.Errorf("d.AddInPlace(d) = %v, want 3.0", got)
+	}
+
+	d = New(15, 1)
+	d.SubInPlace(d.Shift(-1))
+	if got := d.String(); got != "1.35" {
+		t.Errorf("d.SubInPlace(d.Shift(-1)) = %v, want 1.35", got)
+	}
+
+	d = New(15, 1)
+	d.MulInPlace(d)
+	if got := d.String(); got != "2.25" {
+		t.Errorf("d.MulInPlace(d) = %v, want 2.25", got)
+	}
+}
+
+func TestDecimal_MulInPlacePanic(t *testing.T) {
+	d := New(2, math.MaxInt32)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+		if d.unscaledValue.Int64() != 2 || d.scale != math.MaxInt32 {
+			t.Errorf("MulInPlace modified d before panicking")
+		}
+	}()
+	d.MulInPlace(New(1, 1))
+}
+
+func BenchmarkDecimal_AddInPlace(b *testing.B) {
+	d := New(123456789, 2)
+	y := New(987654321, 2)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.AddInPlace(y)
+	}
+}
+
+func BenchmarkDecimal_SubInPlace(b *testing.B) {
+	d := New(123456789, 2)
+	y := New(987654321, 2)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.SubInPlace(y)
+	}
+}
+
+func BenchmarkDecimal_MulInPlace(b *testing.B) {
+	y := New(1, 0)
+	d := New(123456789, 2)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d.MulInPlace(y)
+	}
+}
+
+func BenchmarkDecimal_Multiply(b *testing.B) {
+	x := New(123456789, 2)
+	y := New(1, 0)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Multiply(y)
+	}
+}
+
+func TestDecimal_InPlaceKeepsPackageValues(t *testing.T) {
+	product, err := ProductCapped(2, RoundHalfEven)
+	if err != nil {
+		t.Fatalf("ProductCapped() unexpected error: %v", err)
+	}
+	exp, err := Zero.Exp(0)
+	if err != nil {
+		t.Fatalf("Exp(0) unexpected error: %v", err)
+	}
+	pow, err := Zero.PowDecimal(Zero, 0)
+	if err != nil {
+		t.Fatalf("PowDecimal(0) unexpected error: %v", err)
+	}
+	results := map[string]Decimal{
+		"ProductCapped()":       product,
+		"Zero.Exp(0)":           exp,
+		"0^0":                   pow,
+		"Ten.Round(0)":          Ten.Round(0, RoundDown),
+		"Ten.Truncate(2)":       Ten.Truncate(2),
+		"Hundred.Significant":   Hundred.RoundToSignificantDigits(3, RoundHalfUp),
+		"One.Abs()":             One.Abs(),
+		"Zero.Shift(0)":         Zero.Shift(0),
+		"plain copy of Hundred": Hundred,
+	}
+	for name, d := range results {
+		a, s, m := d, d, d
+		a.AddInPlace(New(41, 0))
+		s.SubInPlace(New(41, 0))
+		m.MulInPlace(New(3, 0))
+		for _, v := range []struct {
+			name  string
+			value Decimal
+			want  string
+		}{{"Zero", Zero, "0"}, {"One", One, "1"}, {"Ten", Ten, "10"}, {"Hundred", Hundred, "100"}} {
+			if got := v.value.String(); got != v.want {
+				t.Fatalf("in-place operations on %s changed %s to %s", name, v.name, got)
+			}
+		}
+	}
+}
+
+func TestDecimal_InPlaceOnRoundingResults(t *testing.T) {
+	rate := Const(21, 2)
+	for name, d := range map[string]Decimal{
+		"Round":                    rate.Round(2, RoundHalfUp),
+		"Truncate":                 rate.Truncate(4),
+		"RoundToSignificantDigits": rate.RoundToSignificantDigits(5, RoundHalfUp),
+		"Ceil":                     New(3, 0).Ceil(),
+	} {
+		d.AddInPlace(One)
+		if got := rate.String(); got != "0.21" {
+			t.Fatalf("AddInPlace on the result of %s changed its input to %s", name, got)
+		}
+	}
+}
@@ -0,0 +1,457 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+)
+
+// The transcendental functions work on fixed-point big.Ints: x stands for
+// x / 10^s at a working scale s that carries mathGuardDigits more digits
+// than the caller asked for, and the result is rounded once at the end.
+const mathGuardDigits = 20
+
+// fixedMul returns a*b at the fixed-point scale whose unit is one = 10^s,
+// truncated. The helpers take the unit rather than s so that series loops
+// look it up once: beyond the pow10 cache, every lookup computes it again.
+func fixedMul(a, b, one *big.Int) *big.Int {
+	p := new(big.Int).Mul(a, b)
+	return p.Quo(p, one)
+}
+
+// fixedDiv returns a/b at the fixed-point scale whose unit is one, truncated.
+func fixedDiv(a, b, one *big.Int) *big.Int {
+	q := new(big.Int).Mul(a, one)
+	return q.Quo(q, b)
+}
+
+// fixedSqrt returns the square root of the non-negative a at the fixed-point
+// scale whose unit is one.
+func fixedSqrt(a, one *big.Int) *big.Int {
+	r := new(big.Int).Mul(a, one)
+	return r.Sqrt(r)
+}
+
+// lnReduced returns ln(m) at scale s for m in [1, 10] at scale s. Square
+// roots bring m close to 1, where ln(m) = 2·artanh((m-1)/(m+1)) converges
+// quickly, and each root taken doubles the final sum.
+func lnReduced(m *big.Int, s int32) *big.Int {
+	one := pow10(s)
+	limit := new(big.Int).Quo(new(big.Int).Mul(one, big.NewInt(11)), big.NewInt(10))
+	m = new(big.Int).Set(m)
+	roots := uint(0)
+	for m.Cmp(limit) > 0 {
+		m = fixedSqrt(m, one)
+		roots++
+	}
+
+	z := fixedDiv(new(big.Int).Sub(m, one), new(big.Int).Add(m, one), one)
+	z2 := fixedMul(z, z, one)
+	sum := new(big.Int).Set(z)
+	term := new(big.Int).Set(z)
+	t := new(big.Int)
+	for i := int64(3); ; i += 2 {
+		term = fixedMul(term, z2, one)
+		t.Quo(term, big.NewInt(i))
+		if t.Sign() == 0 {
+			break
+		}
+		sum.Add(sum, t)
+	}
+	return sum.Lsh(sum, roots+1)
+}
+
+// constantCache holds a mathematical constant at the highest fixed-point
+// scale computed so far, so that requests at a lower scale are served by
+// truncating the cached digits instead of recomputing them.
+type constantCache struct {
+	mu      sync.Mutex
+	scale   int32
+	value   *big.Int
+	compute func(s int32) *big.Int
+}
+
+// at returns the constant at fixed-point scale s, computing and caching it
+// first if the cache is less precise.
+func (c *constantCache) at(s int32) *big.Int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.value == nil || c.scale < s {
+		c.value = c.compute(s)
+		c.scale = s
+	}
+	return new(big.Int).Quo(c.value, pow10(c.scale-s))
+}
+
+var lnTenCache = constantCache{compute: func(s int32) *big.Int {
+	return lnReduced(new(big.Int).Mul(big.NewInt(10), pow10(s)), s)
+}}
+
+// lnTen returns ln(10) at fixed-point scale s.
+func lnTen(s int32) *big.Int {
+	return lnTenCache.at(s)
+}
+
+// lnFixed returns ln(d) at fixed-point scale s for a positive d. d is split
+// into m·10^k with m in [1, 10), so ln(d) = ln(m) + k·ln(10).
+func (d Decimal) lnFixed(s int32) *big.Int {
+	k := int64(numDigits(d.unscaledValue)) - 1 - int64(d.scale)
+	// m = coefficient · 10^(s - digits + 1) at scale s
+	shift := int64(s) - int64(numDigits(d.unscaledValue)) + 1
+	m := new(big.Int).Set(d.unscaledValue)
+	if shift >= 0 {
+		m.Mul(m, pow10(int32(shift)))
+	} else {
+		m.Quo(m, pow10(int32(-shift)))
+	}
+
+	ln := lnReduced(m, s)
+	if k != 0 {
+		ln.Add(ln, new(big.Int).Mul(lnTen(s), big.NewInt(k)))
+	}
+	return ln
+}
+
+// Ln returns the natural logarithm of d rounded with RoundHalfUp to
+// precision fractional digits. The result is computed with extra guard
+// digits on fixed-point integers: d is reduced to m·10^k with m in [1, 10)
+// against a cached ln(10), m is brought close to 1 with square roots, and
+// ln(m) is summed from its arctanh series. Ln of 1 is exactly zero. It
+// returns an error if d is not positive or precision is negative.
+func (d Decimal) Ln(precision int32) (Decimal, error) {
+	if d.Sign() <= 0 {
+		return Decimal{}, fmt.Errorf("logarithm of non-positive value %s", d)
+	}
+	if precision < 0 {
+		return Decimal{}, fmt.Errorf("precision must be non-negative for Ln, got %d", precision)
+	}
+
+	s := precision + mathGuardDigits
+	ln := Decimal{unscaledValue: d.lnFixed(s), scale: s}
+	return ln.Round(precision, RoundHalfUp), nil
+}
+
+// maxExpArgument bounds Exp: e^230258 is just below 10^100000, the largest
+// result Exp is willing to compute.
+const maxExpArgument = 230258
+
+// expReduced returns e^r at scale s for a fixed-point r of small magnitude.
+// r is halved until it is below 0.01, e^r is summed from its Taylor series,
+// and the sum is squared back once per halving.
+func expReduced(r *big.Int, s int32) *big.Int {
+	one := pow10(s)
+	limit := new(big.Int).Quo(one, big.NewInt(100))
+	r = new(big.Int).Set(r)
+	halvings := 0
+	for new(big.Int).Abs(r).Cmp(limit) > 0 {
+		r.Quo(r, big.NewInt(2))
+		halvings++
+	}
+
+	sum := new(big.Int).Add(one, r)
+	term := new(big.Int).Set(r)
+	for i := int64(2); term.Sign() != 0; i++ {
+		term = fixedMul(term, r, one)
+		term.Quo(term, big.NewInt(i))
+		sum.Add(sum, term)
+	}
+	for ; halvings > 0; halvings-- {
+		sum = fixedMul(sum, sum, one)
+	}
+	return sum
+}
+
+// Exp returns e^d rounded with RoundHalfUp to precision fractional digits.
+// d is split as j·ln(10) + r with an integer j, so that e^d = 10^j · e^r and
+// only e^r, with r in [0, ln 10), is evaluated from the Taylor series at a
+// working precision that covers the integer digits of the result. Exp of 0
+// is exactly 1. Arguments so negative that e^d vanishes at precision return
+// zero; it returns an error if precision is negative or d exceeds 230258,
+// beyond which the result would have more than 100000 digits.
+func (d Decimal) Exp(precision int32) (Decimal, error) {
+	if precision < 0 {
+		return Decimal{}, fmt.Errorf("precision must be non-negative for Exp, got %d", precision)
+	}
+	if d.GreaterThan(New(maxExpArgument, 0)) {
+		return Decimal{}, fmt.Errorf("exponent %s is too large for Exp", d)
+	}
+	if d.IsZero() {
+		return New(1, 0).Round(precision, RoundDown), nil
+	}
+	// ln(10) < 3, so below -3·(precision+2) the result is under 10^-(precision+2)
+	if d.LessThan(NewFromInt64(-3 * (int64(precision) + 2))) {
+		return Decimal{unscaledValue: new(big.Int), scale: precision}, nil
+	}
+
+	// A modest precision suffices to find j; an error of one in j only
+	// moves r slightly outside [0, ln 10)
+	estimate := int32(mathGuardDigits)
+	j := new(big.Int).Div(d.coefficientAt(estimate), lnTen(estimate)).Int64()
+
+	s := max(int64(precision)+j, 0) + 2*mathGuardDigits
+	r := new(big.Int).Sub(d.coefficientAt(int32(s)), new(big.Int).Mul(lnTen(int32(s)), big.NewInt(j)))
+	result := Decimal{unscaledValue: expReduced(r, int32(s)), scale: int32(s - j)}
+	return result.Round(precision, RoundHalfUp), nil
+}
+
+// Log10 returns the base-10 logarithm of d rounded with RoundHalfUp to
+// precision fractional digits, computed as Ln(d)/Ln(10) with guard digits.
+// Exact powers of ten give exact integers, so Log10 of 1000 is 3 at any
+// precision. It returns an error if d is not positive or precision is
+// negative.
+func (d Decimal) Log10(precision int32) (Decimal, error) {
+	if d.Sign() <= 0 {
+		return Decimal{}, fmt.Errorf("logarithm of non-positive value %s", d)
+	}
+	if precision < 0 {
+		return Decimal{}, fmt.Errorf("precision must be non-negative for Log10, got %d", precision)
+	}
+	if n := d.Normalize(); n.unscaledValue.Cmp(big.NewInt(1)) == 0 {
+		return New(-int64(n.scale), 0).rescale(precision), nil
+	}
+
+	s := precision + mathGuardDigits
+	ln := Decimal{unscaledValue: d.lnFixed(s), scale: s}
+	return ln.Divide(Decimal{unscaledValue: lnTen(s), scale: s}, precision, RoundHalfUp)
+}
+
+// maxPowDigits bounds the exact coefficient PowDecimal builds for an
+// integer exponent; larger powers are evaluated through Exp and Ln instead.
+const maxPowDigits = 100000
+
+// powInt returns d^n rounded with RoundHalfUp to precision fractional
+// digits, and false if the exact power would be too large to build.
+func (d Decimal) powInt(n *big.Int, precision int32) (Decimal, bool, error) {
+	if !n.IsInt64() {
+		return Decimal{}, false, nil
+	}
+	abs := n.Int64()
+	if abs < 0 {
+		abs = -abs
+	}
+	scale := int64(d.scale) * abs
+	if abs > maxPowDigits/int64(numDigits(d.unscaledValue)) || scale < math.MinInt32 || scale > math.MaxInt32 {
+		return Decimal{}, false, nil
+	}
+
+	p := Decimal{
+		unscaledValue: new(big.Int).Exp(d.unscaledValue, big.NewInt(abs), nil),
+		scale:         int32(scale),
+	}
+	if n.Sign() < 0 {
+		q, err := One.Divide(p, precision, RoundHalfUp)
+		return q, true, err
+	}
+	return p.Round(precision, RoundHalfUp), true, nil
+}
+
+// PowDecimal returns d^exp rounded with RoundHalfUp to precision fractional
+// digits. Integer exponents are computed exactly by repeated squaring when
+// the result is of reasonable size; otherwise d^exp = e^(exp·ln|d|), with ln
+// evaluated at enough digits to cover both the integer digits of the result
+// and the magnification by exp. A negative base is only allowed with an
+// integer exponent, and 0 to a negative power is a division by zero; both
+// return an error, as does a negative precision.
+func (d Decimal) PowDecimal(exp Decimal, precision int32) (Decimal, error) {
+	if precision < 0 {
+		return Decimal{}, fmt.Errorf("precision must be non-negative for PowDecimal, got %d", precision)
+	}
+	// Digits of exp before the point, bounded before integerValue
+	// materializes them and before any message formats exp
+	expDigits := max(int64(numDigits(exp.unscaledValue))-int64(exp.scale), 0)
+	if expDigits > maxPowDigits {
+		return Decimal{}, fmt.Errorf("exponent with %d integer digits is too large for PowDecimal", expDigits)
+	}
+	// A non-zero exp with no integer digits is a pure fraction
+	n, integral := new(big.Int), exp.IsZero()
+	if expDigits > 0 {
+		var err error
+		n, err = exp.integerValue()
+		integral = err == nil
+	}
+	if d.Sign() < 0 && !integral {
+		return Decimal{}, fmt.Errorf("negative base %s with non-integer exponent %s", d, exp)
+	}
+	if d.IsZero() {
+		switch exp.Sign() {
+		case 0:
+			return New(1, 0).Round(precision, RoundDown), nil
+		case -1:
+			return Decimal{}, fmt.Errorf("%w: %s raised to %s", ErrDivisionByZero, d, exp)
+		}
+		return Decimal{unscaledValue: new(big.Int), scale: precision}, nil
+	}
+	if integral {
+		if p, ok, err := d.powInt(n, precision); ok {
+			return p, err
+		}
+	}
+
+	base := d.Abs()
+	// The integer digits of exp multiply the error in ln|d|
+	estimate := int32(mathGuardDigits + expDigits + int64(numDigits(base.unscaledValue)))
+	y := exp.Multiply(Decimal{unscaledValue: base.lnFixed(estimate), scale: estimate})
+	if y.GreaterThan(New(maxExpArgument+1, 0)) {
+		return Decimal{}, fmt.Errorf("%s raised to %s is too large for PowDecimal", d, exp)
+	}
+	// y/ln(10) is the number of integer digits in the result
+	intDigits := int64(1)
+	if y.Sign() > 0 {
+		whole, _ := y.Truncate(0).Int64()
+		intDigits += whole / 2
+	}
+	s := int32(int64(precision) + intDigits + expDigits + mathGuardDigits)
+	y = exp.Multiply(Decimal{unscaledValue: base.lnFixed(s), scale: s}).Round(s, RoundDown)
+
+	result, err := y.Exp(precision)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return result.NegIf(d.Sign() < 0 && n.Bit(0) == 1), nil
+}
+
+// nthRoot returns the floor of the nth root of the non-negative x, by
+// Newton's iteration from an initial guess above the root.
+func nthRoot(x *big.Int, n int64) *big.Int {
+	if x.Sign() == 0 || n == 1 {
+		return new(big.Int).Set(x)
+	}
+	if n == 2 {
+		return new(big.Int).Sqrt(x)
+	}
+
+	bn, bn1 := big.NewInt(n), big.NewInt(n-1)
+	r := new(big.Int).Lsh(big.NewInt(1), uint((int64(x.BitLen())+n-1)/n))
+	next, t := new(big.Int), new(big.Int)
+	for {
+		// next = ((n-1)·r + x / r^(n-1)) / n
+		t.Exp(r, bn1, nil)
+		t.Quo(x, t)
+		next.Mul(r, bn1)
+		next.Add(next, t)
+		next.Quo(next, bn)
+		if next.Cmp(r) >= 0 {
+			return r
+		}
+		r, next = next, r
+	}
+}
+
+// Root returns the nth root of d rounded with mode to precision fractional
+// digits. The root is found with Newton's iteration on d scaled to integer
+// digits one beyond precision; a root that falls strictly between two of
+// those gets a sticky trailing digit, so mode sees an exact tie only for an
+// exact root such as the cube root of 27 or the square root of 0.25. A
+// negative n gives the root of 1/d. It returns an error if n is zero, d is
+// negative and n is even, d is zero and n is negative, precision is
+// negative, or the root is inexact with RoundUnnecessary.
+func (d Decimal) Root(n int32, precision int32, mode RoundingMode) (Decimal, error) {
+	switch {
+	case n == 0:
+		return Decimal{}, fmt.Errorf("zeroth root of %s is undefined", d)
+	case d.Sign() < 0 && n%2 == 0:
+		return Decimal{}, fmt.Errorf("even root %d of negative value %s", n, d)
+	case d.IsZero() && n < 0:
+		return Decimal{}, fmt.Errorf("%w: root %d of zero", ErrDivisionByZero, n)
+	case precision < 0:
+		return Decimal{}, fmt.Errorf("precision must be non-negative for Root, got %d", precision)
+	}
+	if d.IsZero() {
+		return Decimal{unscaledValue: new(big.Int), scale: precision}, nil
+	}
+
+	// |d|^(1/n) scaled by 10^(precision+1) is the nth root of num/den
+	m := int64(n)
+	if m < 0 {
+		m = -m
+	}
+	shift := m*(int64(precision)+1) - int64(d.scale)
+	if n < 0 {
+		shift = m*(int64(precision)+1) + int64(d.scale)
+	}
+	if shift < math.MinInt32 || shift > math.MaxInt32 {
+		return Decimal{}, fmt.Errorf("root %d of %s at precision %d is out of range", n, d, precision)
+	}
+	num, den := big.NewInt(1), new(big.Int).Abs(d.unscaledValue)
+	if n > 0 {
+		num, den = den, num
+	}
+	if shift >= 0 {
+		num = new(big.Int).Mul(num, pow10(int32(shift)))
+	} else {
+		den = new(big.Int).Mul(den, pow10(int32(-shift)))
+	}
+
+	x, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	r := nthRoot(x, m)
+	root := Decimal{unscaledValue: r, scale: precision + 1}
+	if rem.Sign() != 0 || new(big.Int).Exp(r, big.NewInt(m), nil).Cmp(x) != 0 {
+		if mode == RoundUnnecessary {
+			return Decimal{}, fmt.Errorf("%w for root %d of %s at precision %d", ErrRoundingNecessary, n, d, precision)
+		}
+		root = Decimal{unscaledValue: r.Add(r.Mul(r, big.NewInt(10)), big.NewInt(1)), scale: precision + 2}
+	} else if mode == RoundUnnecessary && new(big.Int).Rem(r, big.NewInt(10)).Sign() != 0 {
+		return Decimal{}, fmt.Errorf("%w for root %d of %s at precision %d", ErrRoundingNecessary, n, d, precision)
+	}
+	return root.NegIf(d.Sign() < 0).Round(precision, mode), nil
+}
+
+// arctanInv returns arctan(1/x) at scale s from its Taylor series
+// 1/x - 1/(3x^3) + 1/(5x^5) - ...
+func arctanInv(x int64, s int32) *big.Int {
+	x2 := big.NewInt(x * x)
+	power := new(big.Int).Quo(pow10(s), big.NewInt(x))
+	sum := new(big.Int).Set(power)
+	t := new(big.Int)
+	for k := int64(3); power.Sign() != 0; k += 2 {
+		power.Quo(power, x2)
+		t.Quo(power, big.NewInt(k))
+		if k%4 == 3 {
+			sum.Sub(sum, t)
+		} else {
+			sum.Add(sum, t)
+		}
+	}
+	return sum
+}
+
+// piCache holds pi from Machin's formula, pi = 16·arctan(1/5) - 4·arctan(1/239).
+var piCache = constantCache{compute: func(s int32) *big.Int {
+	pi := new(big.Int).Lsh(arctanInv(5, s), 4)
+	return pi.Sub(pi, new(big.Int).Lsh(arctanInv(239, s), 2))
+}}
+
+// Pi returns pi rounded with RoundHalfUp to precision fractional digits. The
+// digits come from Machin's formula and the most precise value computed so
+// far is cached, so asking again for as many digits or fewer only rounds the
+// cached value. It panics if precision is negative.
+func Pi(precision int32) Decimal {
+	if precision < 0 {
+		panic(fmt.Sprintf("Pi: precision must be non-negative, got %d", precision))
+	}
+	s := precision + mathGuardDigits
+	return Decimal{unscaledValue: piCache.at(s), scale: s}.Round(precision, RoundHalfUp)
+}
+
+// eCache holds e from the factorial series 1 + 1/1! + 1/2! + ...
+var eCache = constantCache{compute: func(s int32) *big.Int {
+	term := new(big.Int).Set(pow10(s))
+	sum := new(big.Int).Set(term)
+	for k := int64(1); term.Sign() != 0; k++ {
+		term.Quo(term, big.NewInt(k))
+		sum.Add(sum, term)
+	}
+	return sum
+}}
+
+// E returns Euler's number e rounded with RoundHalfUp to precision fractional
+// digits. Like Pi, the most precise value computed so far is cached. It
+// panics if precision is negative.
+func E(precision int32) Decimal {
+	if precision < 0 {
+		panic(fmt.Sprintf("E: precision must be non-negative, got %d", precision))
+	}
+	s := precision + mathGuardDigits
+	return Decimal{unscaledValue: eCache.at(s), scale: s}.Round(precision, RoundHalfUp)
+}
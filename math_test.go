@@ -0,0 +1,425 @@
+package decimal
+
+import (
+	"strconv"
+	"testing"
+)
+
+const (
+	refE     = "2.718281828459045235360287471352662497757247093699959574966967627724"
+	refLn2   = "0.693147180559945309417232121458176568075500134360255254120680009493"
+	refLn10  = "2.302585092994045684017991454684364207601101488628772976033327900967"
+	refPi    = "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706798214808651328230664709384460955058223172535940812848111745028410270193852110555964462294895493038196"
+	refSqrt2 = "1.414213562373095048801688724209698078569671875376948073176679737990"
+)
+
+// mustParse parses s or fails the test.
+func mustParse(t *testing.T, s string) Decimal {
+	t.Helper()
+	d, err := NewFromString(s)
+	if err != nil {
+		t.Fatalf("NewFromString(%q) unexpected error: %v", s, err)
+	}
+	return d
+}
+
+// rounded returns the reference value ref rounded to precision digits.
+func rounded(t *testing.T, ref string, precision int32) string {
+	t.Helper()
+	return mustParse(t, ref).Round(precision, RoundHalfUp).String()
+}
+
+func TestDecimal_Ln(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		precision int32
+		want      string
+	}{
+		{"e", refE, 40, "1.0000000000000000000000000000000000000000"},
+		{"2", "2", 40, ""},
+		{"10", "10", 40, ""},
+		{"one", "1", 10, "0.0000000000"},
+		{"half", "0.5", 20, "-0.69314718055994530942"},
+		{"1000", "1000", 30, "6.907755278982137052053974364053"},
+		{"0.001", "0.001", 30, "-6.907755278982137052053974364053"},
+		{"tiny", "1e-1000", 10, "-2302.5850929940"},
+		{"huge", "1e+1000000", 5, "2302585.09299"},
+		{"precision zero", "100", 0, "5"},
+	}
+	wants := map[string]string{"2": refLn2, "10": refLn10}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			if ref, ok := wants[tt.input]; ok {
+				want = rounded(t, ref, tt.precision)
+			}
+			got, err := mustParse(t, tt.input).Ln(tt.precision)
+			if err != nil {
+				t.Fatalf("Ln(%d) unexpected error: %v", tt.precision, err)
+			}
+			if got.String() != want {
+				t.Errorf("Ln(%d) of %s = %v, want %v", tt.precision, tt.name, got, want)
+			}
+		})
+	}
+}
+
+func TestDecimal_LnErrors(t *testing.T) {
+	for _, d := range []Decimal{New(0, 0), New(0, 5), New(-1, 0)} {
+		if _, err := d.Ln(10); err == nil {
+			t.Errorf("Ln() of %v expected an error", d)
+		}
+	}
+	if _, err := New(2, 0).Ln(-1); err == nil {
+		t.Errorf("Ln(-1) expected an error")
+	}
+}
+
+func TestDecimal_Exp(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		precision int32
+		want      string
+	}{
+		{"one", "1", 40, ""},
+		{"zero", "0", 0, "1"},
+		{"zero padded", "0.000", 5, "1.00000"},
+		{"minus one", "-1", 30, "0.367879441171442321595523770161"},
+		{"ln 2", refLn2, 30, "2.000000000000000000000000000000"},
+		{"ten", "10", 20, "22026.46579480671651695790"},
+		{"half", "0.5", 25, "1.6487212707001281468486508"},
+		{"hundred", "100", 5, "26881171418161354484126255515800135873611118.77374"},
+		{"very negative", "-1000", 10, "0.0000000000"},
+		{"small negative", "-20", 10, "0.0000000021"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			if tt.name == "one" {
+				want = rounded(t, refE, tt.precision)
+			}
+			got, err := mustParse(t, tt.input).Exp(tt.precision)
+			if err != nil {
+				t.Fatalf("Exp(%d) unexpected error: %v", tt.precision, err)
+			}
+			if got.String() != want {
+				t.Errorf("Exp(%d) of %s = %v, want %v", tt.precision, tt.input, got, want)
+			}
+		})
+	}
+}
+
+func TestDecimal_ExpZeroIsExact(t *testing.T) {
+	got, err := Zero.Exp(0)
+	if err != nil {
+		t.Fatalf("Exp() unexpected error: %v", err)
+	}
+	if !got.StrictEqual(One) {
+		t.Errorf("Exp(0) = %v scale %d, want exactly 1", got, got.scale)
+	}
+}
+
+func TestDecimal_ExpLnRoundTrip(t *testing.T) {
+	for _, input := range []string{"0.001", "1.5", "42", "123456.789"} {
+		t.Run(input, func(t *testing.T) {
+			d := mustParse(t, input)
+			ln, err := d.Ln(40)
+			if err != nil {
+				t.Fatalf("Ln() unexpected error: %v", err)
+			}
+			got, err := ln.Exp(20)
+			if err != nil {
+				t.Fatalf("Exp() unexpected error: %v", err)
+			}
+			if want := d.Round(20, RoundHalfUp); !got.Equal(want) {
+				t.Errorf("Exp(Ln(%s)) = %v, want %v", input, got, want)
+			}
+		})
+	}
+}
+
+func TestDecimal_ExpErrors(t *testing.T) {
+	if _, err := New(1, 0).Exp(-1); err == nil {
+		t.Errorf("Exp(-1) expected an error")
+	}
+	if _, err := New(230259, 0).Exp(2); err == nil {
+		t.Errorf("Exp() of 230259 expected an error")
+	}
+	if _, err := New(1, -10).Exp(2); err == nil {
+		t.Errorf("Exp() of 1e10 expected an error")
+	}
+}
+
+func TestDecimal_Log10(t *testing.T) {
+	tests := []struct {
+		input     string
+		precision int32
+		want      string
+	}{
+		{"1000", 0, "3"},
+		{"1000", 4, "3.0000"},
+		{"1", 3, "0.000"},
+		{"0.001", 2, "-3.00"},
+		{"1E+50", 0, "50"},
+		{"100.00", 1, "2.0"},
+		{"2", 30, "0.301029995663981195213738894724"},
+		{"20", 10, "1.3010299957"},
+		{"0.5", 10, "-0.3010299957"},
+		{"3.16227766", 5, "0.50000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := mustParse(t, tt.input).Log10(tt.precision)
+			if err != nil {
+				t.Fatalf("Log10(%d) unexpected error: %v", tt.precision, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Log10(%d) of %s = %v, want %v", tt.precision, tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Log10Errors(t *testing.T) {
+	for _, input := range []string{"0", "-10"} {
+		if _, err := mustParse(t, input).Log10(2); err == nil {
+			t.Errorf("Log10() of %s expected an error", input)
+		}
+	}
+	if _, err := New(10, 0).Log10(-1); err == nil {
+		t.Errorf("Log10(-1) expected an error")
+	}
+}
+
+func TestDecimal_PowDecimal(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		exp       string
+		precision int32
+		want      string
+	}{
+		{"square root of two", "2", "0.5", 40, ""},
+		{"cube root of eight", "8", "0.333333333333333333333333333333", 10, "2.0000000000"},
+		{"integer", "1.5", "3", 3, "3.375"},
+		{"integer rounded", "1.05", "2", 2, "1.10"},
+		{"integer written with scale", "3", "2.000", 0, "9"},
+		{"negative integer", "2", "-3", 4, "0.1250"},
+		{"negative base odd", "-2", "3", 0, "-8"},
+		{"negative base even", "-2", "4", 0, "16"},
+		{"zero exponent", "7.25", "0", 2, "1.00"},
+		{"zero base", "0", "2.5", 3, "0.000"},
+		{"zero to zero", "0", "0", 0, "1"},
+		{"fractional base", "0.25", "0.5", 5, "0.50000"},
+		{"fractional exponent", "10", "2.5", 10, "316.2277660168"},
+		{"large integer exponent", "1.0000001", "10000000", 20, "2.71828169254496627120"},
+		{"negative base large odd exponent", "-1.0000001", "10000001", 10, "-2.7182819644"},
+		{"one", "1", "123456789.123", 3, "1.000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			if want == "" {
+				want = rounded(t, refSqrt2, tt.precision)
+			}
+			got, err := mustParse(t, tt.base).PowDecimal(mustParse(t, tt.exp), tt.precision)
+			if err != nil {
+				t.Fatalf("PowDecimal() unexpected error: %v", err)
+			}
+			if got.String() != want {
+				t.Errorf("%s.PowDecimal(%s, %d) = %v, want %v", tt.base, tt.exp, tt.precision, got, want)
+			}
+		})
+	}
+}
+
+func TestDecimal_PowDecimalErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		exp       string
+		precision int32
+	}{
+		{"negative base fractional exponent", "-8", "0.5", 5},
+		{"zero to negative power", "0", "-1", 5},
+		{"negative precision", "2", "2", -1},
+		{"too large", "10", "100001", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := mustParse(t, tt.base).PowDecimal(mustParse(t, tt.exp), tt.precision); err == nil {
+				t.Errorf("%s.PowDecimal(%s, %d) expected an error", tt.base, tt.exp, tt.precision)
+			}
+		})
+	}
+}
+
+func TestDecimal_Root(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		n         int32
+		precision int32
+		mode      RoundingMode
+		want      string
+	}{
+		{"square root of two", "2", 2, 40, RoundHalfUp, ""},
+		{"perfect square", "144", 2, 0, RoundUnnecessary, "12"},
+		{"perfect square padded", "1.44", 2, 3, RoundUnnecessary, "1.200"},
+		{"cube root of 27", "27", 3, 5, RoundUnnecessary, "3.00000"},
+		{"cube root of negative", "-27", 3, 0, RoundUnnecessary, "-3"},
+		{"cube root of ten", "10", 3, 20, RoundHalfUp, "2.15443469003188372176"},
+		{"cube root truncated", "10", 3, 2, RoundDown, "2.15"},
+		{"cube root ceiling", "10", 3, 2, RoundCeiling, "2.16"},
+		{"negative cube root floor", "-10", 3, 2, RoundFloor, "-2.16"},
+		{"fourth root of 16", "16", 4, 2, RoundUnnecessary, "2.00"},
+		{"fourth root of two", "2", 4, 15, RoundHalfUp, "1.189207115002721"},
+		{"fourth root of a fraction", "0.0081", 4, 1, RoundUnnecessary, "0.3"},
+		{"exact tie rounds half up", "0.25", 2, 0, RoundHalfUp, "1"},
+		{"exact tie rounds half down", "0.25", 2, 0, RoundHalfDown, "0"},
+		{"just above tie rounds up", "0.2500001", 2, 0, RoundHalfDown, "1"},
+		{"first root", "1.23", 1, 1, RoundHalfUp, "1.2"},
+		{"negative n", "100", -3, 10, RoundHalfUp, "0.2154434690"},
+		{"zero", "0", 5, 2, RoundUnnecessary, "0.00"},
+		{"large scale", "1E-30", 3, 0, RoundHalfUp, "0"},
+		{"negative scale", "1E+30", 3, 0, RoundUnnecessary, "10000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.want
+			if want == "" {
+				want = rounded(t, refSqrt2, tt.precision)
+			}
+			got, err := mustParse(t, tt.input).Root(tt.n, tt.precision, tt.mode)
+			if err != nil {
+				t.Fatalf("Root(%d, %d, %v) unexpected error: %v", tt.n, tt.precision, tt.mode, err)
+			}
+			if got.String() != want {
+				t.Errorf("Root(%d, %d, %v) of %s = %v, want %v", tt.n, tt.precision, tt.mode, tt.input, got, want)
+			}
+		})
+	}
+}
+
+func TestDecimal_RootErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		n         int32
+		precision int32
+		mode      RoundingMode
+	}{
+		{"zeroth root", "8", 0, 2, RoundHalfUp},
+		{"even root of negative", "-16", 4, 2, RoundHalfUp},
+		{"negative root of zero", "0", -2, 2, RoundHalfUp},
+		{"negative precision", "8", 3, -1, RoundHalfUp},
+		{"inexact root", "2", 2, 5, RoundUnnecessary},
+		{"root exact beyond precision", "2.25", 2, 0, RoundUnnecessary},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := mustParse(t, tt.input).Root(tt.n, tt.precision, tt.mode); err == nil {
+				t.Errorf("Root(%d, %d, %v) of %s expected an error", tt.n, tt.precision, tt.mode, tt.input)
+			}
+		})
+	}
+}
+
+func TestPi(t *testing.T) {
+	for _, precision := range []int32{0, 1, 4, 50, 150} {
+		t.Run(strconv.Itoa(int(precision)), func(t *testing.T) {
+			if got, want := Pi(precision).String(), rounded(t, refPi, precision); got != want {
+				t.Errorf("Pi(%d) = %v, want %v", precision, got, want)
+			}
+		})
+	}
+	if got := Pi(4).String(); got != "3.1416" {
+		t.Errorf("Pi(4) = %v, want 3.1416", got)
+	}
+}
+
+func TestPiCache(t *testing.T) {
+	Pi(120)
+	piCache.mu.Lock()
+	scale := piCache.scale
+	piCache.mu.Unlock()
+	if scale < 120 {
+		t.Fatalf("after Pi(120) the cache holds scale %d, want at least 120", scale)
+	}
+
+	if got, want := Pi(30).String(), rounded(t, refPi, 30); got != want {
+		t.Errorf("Pi(30) from the cache = %v, want %v", got, want)
+	}
+	piCache.mu.Lock()
+	defer piCache.mu.Unlock()
+	if piCache.scale != scale {
+		t.Errorf("Pi(30) changed the cached scale from %d to %d", scale, piCache.scale)
+	}
+}
+
+func TestPiPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	Pi(-1)
+}
+
+func TestE(t *testing.T) {
+	for _, precision := range []int32{0, 1, 10, 40, 60} {
+		t.Run(strconv.Itoa(int(precision)), func(t *testing.T) {
+			if got, want := E(precision).String(), rounded(t, refE, precision); got != want {
+				t.Errorf("E(%d) = %v, want %v", precision, got, want)
+			}
+		})
+	}
+}
+
+func TestEMatchesExp(t *testing.T) {
+	got := E(40)
+	want, err := NewFromInt(1).Exp(40)
+	if err != nil {
+		t.Fatalf("Exp(40) unexpected error: %v", err)
+	}
+	if got.Cmp(want) != 0 {
+		t.Errorf("E(40) = %v, want Exp(1) = %v", got, want)
+	}
+}
+
+func TestEPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	E(-1)
+}
+
+func TestDecimal_PowDecimalHugeExponent(t *testing.T) {
+	for _, exp := range []Decimal{New(1, -2000000000), New(-3, -2000000000)} {
+		if _, err := New(2, 0).PowDecimal(exp, 2); err == nil {
+			t.Errorf("PowDecimal with exponent scale %d expected an error", exp.Scale())
+		}
+		if _, err := Zero.PowDecimal(exp, 2); err == nil {
+			t.Errorf("0.PowDecimal with exponent scale %d expected an error", exp.Scale())
+		}
+	}
+}
+
+func TestMathBeyondPow10Cache(t *testing.T) {
+	x := mustParse(t, "2.5")
+	ln, _ := x.Ln(60)
+	exp, _ := x.Exp(60)
+
+	defer SetPow10CacheSize(DefaultPow10CacheSize)
+	SetPow10CacheSize(0)
+	if got, _ := x.Ln(60); !got.StrictEqual(ln) {
+		t.Errorf("Ln(60) beyond the pow10 cache = %v, want %v", got, ln)
+	}
+	if got, _ := x.Exp(60); !got.StrictEqual(exp) {
+		t.Errorf("Exp(60) beyond the pow10 cache = %v, want %v", got, exp)
+	}
+}
@@ -0,0 +1,63 @@
+package decimal
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// NullDecimal represents a Decimal that may be NULL, mirroring
+// sql.NullString. It implements the sql.Scanner and driver.Valuer interfaces
+// so it can be used for optional NUMERIC/DECIMAL columns.
+type NullDecimal struct {
+	Decimal Decimal
+	Valid   bool // Valid is true if Decimal is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+// SQL NULL sets Valid to false and leaves Decimal as zero; any other value is
+// parsed with Decimal.Scan and sets Valid to true.
+func (n *NullDecimal) Scan(value interface{}) error {
+	if value == nil {
+		n.Decimal, n.Valid = Decimal{}, false
+		return nil
+	}
+	if err := n.Decimal.Scan(value); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns nil (SQL NULL) when the NullDecimal is not valid.
+func (n NullDecimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Decimal.Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// An invalid NullDecimal is emitted as null.
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Decimal.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// JSON null sets Valid to false; any other value is parsed as a Decimal.
+func (n *NullDecimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		n.Decimal, n.Valid = Decimal{}, false
+		return nil
+	}
+	if err := n.Decimal.UnmarshalJSON(data); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,100 @@
+package decimal
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+)
+
+func TestNullDecimal_Scan(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     interface{}
+		wantValid bool
+		want      string
+		wantErr   bool
+	}{
+		{"null", nil, false, "", false},
+		{"string", "123.45", true, "123.45", false},
+		{"bytes", []byte("-0.50"), true, "-0.50", false},
+		{"invalid", "abc", false, "", true},
+		{"unsupported type", true, false, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n NullDecimal
+			err := n.Scan(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan(%v) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if n.Valid != tt.wantValid {
+				t.Errorf("Scan(%v) Valid = %t, want %t", tt.input, n.Valid, tt.wantValid)
+			}
+			if tt.wantValid && n.Decimal.String() != tt.want {
+				t.Errorf("Scan(%v) Decimal = %v, want %v", tt.input, n.Decimal, tt.want)
+			}
+			if !tt.wantValid && !n.Decimal.IsZero() {
+				t.Errorf("Scan(%v) Decimal = %v, want zero", tt.input, n.Decimal)
+			}
+		})
+	}
+}
+
+func TestNullDecimal_Value(t *testing.T) {
+	tests := []struct {
+		name  string
+		input NullDecimal
+		want  driver.Value
+	}{
+		{"invalid", NullDecimal{}, nil},
+		{"invalid ignores decimal", NullDecimal{Decimal: New(5, 0)}, nil},
+		{"valid", NullDecimal{Decimal: New(12345, 2), Valid: true}, "123.45"},
+		{"valid zero", NullDecimal{Valid: true}, "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := driver.DefaultParameterConverter.ConvertValue(tt.input)
+			if err != nil {
+				t.Fatalf("ConvertValue() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ConvertValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullDecimal_JSON(t *testing.T) {
+	type payload struct {
+		Discount NullDecimal `json:"discount"`
+	}
+	tests := []struct {
+		name  string
+		input payload
+		want  string
+	}{
+		{"invalid", payload{}, `{"discount":null}`},
+		{"valid", payload{NullDecimal{Decimal: New(150, 2), Valid: true}}, `{"discount":1.50}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.input)
+			if err != nil {
+				t.Fatalf("Marshal() unexpected error: %v", err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", data, tt.want)
+			}
+			var got payload
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal(%s) unexpected error: %v", data, err)
+			}
+			if got.Discount.Valid != tt.input.Discount.Valid {
+				t.Errorf("Unmarshal(%s) Valid = %t, want %t", data, got.Discount.Valid, tt.input.Discount.Valid)
+			}
+			if got.Discount.Valid && !got.Discount.Decimal.StrictEqual(tt.input.Discount.Decimal) {
+				t.Errorf("Unmarshal(%s) Decimal = %v, want %v", data, got.Discount.Decimal, tt.input.Discount.Decimal)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package decimal
 
 import (
 	"fmt"
+	"math"
 	"math/big"
+	"strings"
 )
 
 // RoundingMode determines how decimal numbers are rounded
@@ -21,10 +23,10 @@ const (
 	// RoundFloor rounds toward negative infinity
 	RoundFloor
 
-	// RoundHalfUp rounds toward nearest neighbor, ties toward positive infinity
+	// RoundHalfUp rounds toward nearest neighbor, ties away from zero
 	RoundHalfUp
 
-	// RoundHalfDown rounds toward nearest neighbor, ties toward negative infinity
+	// RoundHalfDown rounds toward nearest neighbor, ties toward zero
 	RoundHalfDown
 
 	// RoundHalfEven rounds toward nearest neighbor, ties toward even neighbor (Default)
@@ -32,6 +34,19 @@ const (
 
 	// RoundUnnecessary throws error if rounding is necessary
 	RoundUnnecessary
+
+	// RoundHalfCeiling rounds toward nearest neighbor, ties toward positive infinity
+	RoundHalfCeiling
+
+	// RoundHalfFloor rounds toward nearest neighbor, ties toward negative infinity
+	RoundHalfFloor
+
+	// RoundHalfOdd rounds toward nearest neighbor, ties toward odd neighbor
+	RoundHalfOdd
+
+	// Round05Up rounds toward zero, unless that leaves a last digit of 0 or 5,
+	// in which case it rounds away from zero (ROUND_05UP)
+	Round05Up
 )
 
 // String returns the string representation of the rounding mode
@@ -53,13 +68,23 @@ func (rm RoundingMode) String() string {
 		return "RoundHalfEven"
 	case RoundUnnecessary:
 		return "RoundUnnecessary"
+	case RoundHalfCeiling:
+		return "RoundHalfCeiling"
+	case RoundHalfFloor:
+		return "RoundHalfFloor"
+	case RoundHalfOdd:
+		return "RoundHalfOdd"
+	case Round05Up:
+		return "Round05Up"
 	default:
 		return fmt.Sprintf("RoundingMode(%d)", rm)
 	}
 }
 
-// shouldRoundUp determines if we should round up based on the remainder and denominator
-func (rm RoundingMode) shouldRoundUp(rem, denom *big.Int) bool {
+// shouldRoundUp determines if we should round up based on the remainder and denominator.
+// quo is the truncated quotient whose last digit is retained, for the modes
+// that break ties by its parity.
+func (rm RoundingMode) shouldRoundUp(quo, rem, denom *big.Int) bool {
 	// If remainder is zero, no rounding needed
 	if rem.Sign() == 0 {
 		return false
@@ -69,11 +94,9 @@ func (rm RoundingMode) shouldRoundUp(rem, denom *big.Int) bool {
 	remAbs := new(big.Int).Abs(rem)
 	denomAbs := new(big.Int).Abs(denom)
 
-	// Calculate half of denominator for comparison
-	half := new(big.Int).Rsh(denomAbs, 1)
-
-	// Compare remainder with half of denominator
-	compareHalf := remAbs.Cmp(half)
+	// Compare twice the remainder with the denominator, which stays exact
+	// for odd denominators where halving would not
+	compareHalf := new(big.Int).Lsh(remAbs, 1).Cmp(denomAbs)
 
 	switch rm {
 	case RoundDown:
@@ -101,8 +124,23 @@ func (rm RoundingMode) shouldRoundUp(rem, denom *big.Int) bool {
 		if compareHalf < 0 {
 			return false
 		}
-		// If exactly half, round to even
-		return rem.Bit(0) == 1
+		// If exactly half, move an odd retained digit to its even neighbour
+		return quo.Bit(0) == 1
+
+	case RoundHalfCeiling:
+		// The remainder carries the sign of the value, so a positive tie
+		// moves away from zero toward positive infinity
+		return compareHalf > 0 || (compareHalf == 0 && rem.Sign() > 0)
+
+	case RoundHalfFloor:
+		return compareHalf > 0 || (compareHalf == 0 && rem.Sign() < 0)
+
+	case RoundHalfOdd:
+		// If exactly half, move an even retained digit to its odd neighbour
+		return compareHalf > 0 || (compareHalf == 0 && quo.Bit(0) == 0)
+
+	case Round05Up:
+		return endsIn0or5(quo)
 
 	case RoundUnnecessary:
 		if rem.Sign() != 0 {
@@ -114,3 +152,223 @@ func (rm RoundingMode) shouldRoundUp(rem, denom *big.Int) bool {
 		panic("unknown rounding mode")
 	}
 }
+
+// valid reports whether rm is one of the defined rounding modes.
+func (rm RoundingMode) valid() bool {
+	return rm >= RoundDown && rm <= Round05Up
+}
+
+// endsIn0or5 reports whether the last decimal digit of x is 0 or 5.
+func endsIn0or5(x *big.Int) bool {
+	return new(big.Int).Rem(x, big.NewInt(5)).Sign() == 0
+}
+
+// Round returns d rounded to the given number of fractional digits using mode.
+// Digits beyond scale are divided out by 10^(d.scale-scale) and the remainder
+// decides, per mode, whether the retained value moves away from zero.
+// Rounding to a scale larger than d's pads with zeros and is always exact.
+// The result never shares d's coefficient, even when nothing changes.
+// RoundUnnecessary panics if digits would be lost.
+func (d Decimal) Round(scale int32, mode RoundingMode) Decimal {
+	d = d.orZero()
+	if scale == d.scale {
+		return d.Copy()
+	}
+	if scale > d.scale {
+		return d.rescale(scale)
+	}
+
+	divisor := pow10(d.scale - scale)
+	quo, rem := new(big.Int).QuoRem(d.unscaledValue, divisor, new(big.Int))
+	if mode.shouldRoundUp(quo, rem, divisor) {
+		// The remainder carries the sign of d, so step away from zero
+		if rem.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	return Decimal{
+		unscaledValue: quo,
+		scale:         scale,
+	}
+}
+
+// CeilToSignificance rounds d to scale fractional digits toward positive
+// infinity, as billing systems do when every invoice line item is rounded
+// up: 10.001 becomes 10.01 at scale 2. For negative amounts (credits) the
+// same RoundCeiling rule moves toward zero, so -10.009 becomes -10.00.
+func (d Decimal) CeilToSignificance(scale int32) Decimal {
+	return d.Round(scale, RoundCeiling)
+}
+
+// Truncate drops the fractional digits of d beyond scale, rounding toward
+// zero: -1.99 truncated to scale 0 is -1, not -2. If d already has at most
+// scale fractional digits a copy of it is returned unchanged.
+func (d Decimal) Truncate(scale int32) Decimal {
+	d = d.orZero()
+	if scale >= d.scale {
+		return d.Copy()
+	}
+	return d.Round(scale, RoundDown)
+}
+
+// RoundToSignificantDigits rounds d with mode to keep at most digits
+// significant figures, choosing the scale from d's magnitude: 123456 to 3
+// figures is 123000 (coefficient 123, scale -3) and 0.0012345 to 2 figures
+// is 0.0012. When rounding carries into a new digit, as 9.99 to 2 figures
+// does, the scale drops by one more so the result still has digits figures.
+// A d with no more than digits figures, including zero, is returned
+// unchanged as a copy. It panics if digits is not positive, and like Round with
+// RoundUnnecessary if non-zero figures would be lost.
+func (d Decimal) RoundToSignificantDigits(digits int32, mode RoundingMode) Decimal {
+	d = d.orZero()
+	if digits <= 0 {
+		panic(fmt.Sprintf("RoundToSignificantDigits: digits must be positive, got %d", digits))
+	}
+	drop := int64(numDigits(d.unscaledValue)) - int64(digits)
+	if d.IsZero() || drop <= 0 {
+		return d.Copy()
+	}
+
+	scale := int64(d.scale) - drop
+	if scale < math.MinInt32 {
+		panic(fmt.Sprintf("RoundToSignificantDigits: scale %d is out of range", scale))
+	}
+	r := d.Round(int32(scale), mode)
+	if int64(numDigits(r.unscaledValue)) > int64(digits) && scale > math.MinInt32 {
+		// The carry left a trailing zero, e.g. 10.0 from 9.99
+		r = r.Round(int32(scale-1), RoundDown)
+	}
+	return r
+}
+
+// ExplainRound describes in plain English how d.Round(scale, mode) reaches its
+// result: which digits are dropped, how they compare to half a unit, and why
+// the mode keeps or moves the retained value. It is meant for teaching and
+// audit trails; unlike Round it never panics for RoundUnnecessary.
+func (d Decimal) ExplainRound(scale int32, mode RoundingMode) string {
+	d = d.orZero()
+	prefix := fmt.Sprintf("rounding %s to %d fractional digits with %s: ", d, scale, mode)
+	if scale >= d.scale {
+		return prefix + fmt.Sprintf("no digits are dropped, so the result is exactly %s", d.Round(scale, mode))
+	}
+
+	dropped := d.scale - scale
+	divisor := pow10(dropped)
+	quo, rem := new(big.Int).QuoRem(d.unscaledValue, divisor, new(big.Int))
+	droppedDigits := new(big.Int).Abs(rem).String()
+	droppedDigits = strings.Repeat("0", int(dropped)-len(droppedDigits)) + droppedDigits
+
+	if rem.Sign() == 0 {
+		return prefix + fmt.Sprintf("the dropped digits %s are all zero, so the result is exactly %s",
+			droppedDigits, d.Round(scale, RoundDown))
+	}
+
+	var position string
+	twiceRem := new(big.Int).Lsh(new(big.Int).Abs(rem), 1)
+	halfCmp := twiceRem.Cmp(divisor)
+	switch {
+	case halfCmp < 0:
+		position = "less than half a unit"
+	case halfCmp == 0:
+		position = "exactly half a unit (a tie)"
+	default:
+		position = "more than half a unit"
+	}
+	explanation := fmt.Sprintf("the dropped digits %s are %s; ", droppedDigits, position)
+
+	if mode == RoundUnnecessary {
+		return prefix + explanation + "RoundUnnecessary does not allow digits to be dropped, so Round would panic"
+	}
+
+	// For a positive value +infinity lies away from zero, for a negative one toward it
+	positiveInf, negativeInf := "away from", "toward"
+	if rem.Sign() < 0 {
+		positiveInf, negativeInf = negativeInf, positiveInf
+	}
+
+	var reason string
+	switch mode {
+	case RoundDown:
+		reason = "RoundDown always truncates toward zero"
+	case RoundUp:
+		reason = "RoundUp moves away from zero whenever digits are dropped"
+	case RoundCeiling:
+		reason = fmt.Sprintf("RoundCeiling moves toward positive infinity, which is %s zero for this value", positiveInf)
+	case RoundFloor:
+		reason = fmt.Sprintf("RoundFloor moves toward negative infinity, which is %s zero for this value", negativeInf)
+	case Round05Up:
+		lastDigit := new(big.Int).Mod(new(big.Int).Abs(quo), big.NewInt(10))
+		verdict := "is neither, so it is kept"
+		if endsIn0or5(quo) {
+			verdict = "is, so it moves away from zero"
+		}
+		reason = fmt.Sprintf("Round05Up moves away from zero only when the retained digit is 0 or 5, and %s %s",
+			lastDigit, verdict)
+	default:
+		if halfCmp != 0 {
+			reason = fmt.Sprintf("%s rounds to the nearest neighbour", mode)
+			break
+		}
+		switch mode {
+		case RoundHalfUp:
+			reason = "RoundHalfUp breaks ties away from zero"
+		case RoundHalfDown:
+			reason = "RoundHalfDown breaks ties toward zero"
+		case RoundHalfCeiling:
+			reason = fmt.Sprintf("RoundHalfCeiling breaks ties toward positive infinity, which is %s zero for this value", positiveInf)
+		case RoundHalfFloor:
+			reason = fmt.Sprintf("RoundHalfFloor breaks ties toward negative infinity, which is %s zero for this value", negativeInf)
+		case RoundHalfEven:
+			lastDigit := new(big.Int).Mod(new(big.Int).Abs(quo), big.NewInt(10))
+			parity := "even, so it is kept"
+			if quo.Bit(0) == 1 {
+				parity = "odd, so it moves to the even neighbour"
+			}
+			reason = fmt.Sprintf("RoundHalfEven breaks ties toward the even neighbour and the retained digit %s is %s",
+				lastDigit, parity)
+		case RoundHalfOdd:
+			lastDigit := new(big.Int).Mod(new(big.Int).Abs(quo), big.NewInt(10))
+			parity := "odd, so it is kept"
+			if quo.Bit(0) == 0 {
+				parity = "even, so it moves to the odd neighbour"
+			}
+			reason = fmt.Sprintf("RoundHalfOdd breaks ties toward the odd neighbour and the retained digit %s is %s",
+				lastDigit, parity)
+		default:
+			reason = fmt.Sprintf("%s decides the tie", mode)
+		}
+	}
+
+	outcome := "the retained digits are kept"
+	if mode.shouldRoundUp(quo, rem, divisor) {
+		outcome = "the retained value moves one unit away from zero"
+	}
+	return prefix + explanation + reason + "; " + outcome + fmt.Sprintf(", giving %s", d.Round(scale, mode))
+}
+
+// Floor returns the largest integer value less than or equal to d, as a
+// Decimal with scale 0: Floor(-1.1) is -2.
+func (d Decimal) Floor() Decimal {
+	return d.Round(0, RoundFloor)
+}
+
+// Ceil returns the smallest integer value greater than or equal to d, as a
+// Decimal with scale 0: Ceil(-1.1) is -1.
+func (d Decimal) Ceil() Decimal {
+	return d.Round(0, RoundCeiling)
+}
+
+// DistanceToInteger returns d minus the integer nearest to d, a value in
+// [-0.5, 0.5] at d's scale: 2.3 gives 0.3 and 2.7 gives -0.3. Exact halves
+// are measured from the integer toward zero, so 2.5 gives 0.5 and -2.5 gives
+// -0.5. Integers, including values with a negative scale, give zero.
+func (d Decimal) DistanceToInteger() Decimal {
+	d = d.orZero()
+	if d.scale <= 0 {
+		return Decimal{unscaledValue: new(big.Int), scale: d.scale}
+	}
+	return d.Sub(d.Round(0, RoundHalfDown))
+}
@@ -3,6 +3,7 @@ package decimal
 import (
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 )
 
@@ -16,60 +17,102 @@ func TestRoundingMode_shouldRoundUp(t *testing.T) {
 	testCases := []struct {
 		name     string
 		mode     RoundingMode
+		quo      *big.Int
 		rem      *big.Int
 		denom    *big.Int
 		expected bool
 	}{
 		// RoundDown: Always returns false unless the remainder is non-zero, in which case it is an error
-		{"RoundDown_NoRounding", RoundDown, i64(0), i64(10), false},
-		{"RoundDown_PositiveRemainder", RoundDown, i64(3), i64(10), false},
-		{"RoundDown_NegativeRemainder", RoundDown, i64(-3), i64(10), false},
+		{"RoundDown_NoRounding", RoundDown, i64(0), i64(0), i64(10), false},
+		{"RoundDown_PositiveRemainder", RoundDown, i64(0), i64(3), i64(10), false},
+		{"RoundDown_NegativeRemainder", RoundDown, i64(0), i64(-3), i64(10), false},
 
 		// RoundUp: Always returns true
-		{"RoundUp_PositiveRemainder", RoundUp, i64(3), i64(10), true},
-		{"RoundUp_NegativeRemainder", RoundUp, i64(-3), i64(10), true},
-		{"RoundUp_NoRemainder", RoundUp, i64(0), i64(10), false},
+		{"RoundUp_PositiveRemainder", RoundUp, i64(0), i64(3), i64(10), true},
+		{"RoundUp_NegativeRemainder", RoundUp, i64(0), i64(-3), i64(10), true},
+		{"RoundUp_NoRemainder", RoundUp, i64(0), i64(0), i64(10), false},
 
 		// RoundCeiling: Rounds up toward positive infinity
-		{"RoundCeiling_PositiveRemainder", RoundCeiling, i64(3), i64(10), true},
-		{"RoundCeiling_NegativeRemainder", RoundCeiling, i64(-3), i64(10), false},
-		{"RoundCeiling_NoRemainder", RoundCeiling, i64(0), i64(10), false},
+		{"RoundCeiling_PositiveRemainder", RoundCeiling, i64(0), i64(3), i64(10), true},
+		{"RoundCeiling_NegativeRemainder", RoundCeiling, i64(0), i64(-3), i64(10), false},
+		{"RoundCeiling_NoRemainder", RoundCeiling, i64(0), i64(0), i64(10), false},
 
 		// RoundFloor: Rounds up toward negative infinity
-		{"RoundFloor_PositiveRemainder", RoundFloor, i64(3), i64(10), false},
-		{"RoundFloor_NegativeRemainder", RoundFloor, i64(-3), i64(10), true},
-		{"RoundFloor_NoRemainder", RoundFloor, i64(0), i64(10), false},
-
-		// RoundHalfUp: Rounds toward nearest neighbor, ties go up (positive infinity)
-		{"RoundHalfUp_LessThanHalf", RoundHalfUp, i64(4), i64(10), false},
-		{"RoundHalfUp_ExactlyHalf", RoundHalfUp, i64(5), i64(10), true},
-		{"RoundHalfUp_MoreThanHalf", RoundHalfUp, i64(6), i64(10), true},
-		{"RoundHalfUp_NegativeLessThanHalf", RoundHalfUp, i64(-4), i64(10), false},
-		{"RoundHalfUp_NegativeExactlyHalf", RoundHalfUp, i64(-5), i64(10), true},
-		{"RoundHalfUp_NegativeMoreThanHalf", RoundHalfUp, i64(-6), i64(10), true},
-
-		// RoundHalfDown: Rounds toward nearest neighbor, ties go down (negative infinity)
-		{"RoundHalfDown_LessThanHalf", RoundHalfDown, i64(4), i64(10), false},
-		{"RoundHalfDown_ExactlyHalf", RoundHalfDown, i64(5), i64(10), false},
-		{"RoundHalfDown_MoreThanHalf", RoundHalfDown, i64(6), i64(10), true},
-		{"RoundHalfDown_NegativeLessThanHalf", RoundHalfDown, i64(-4), i64(10), false},
-		{"RoundHalfDown_NegativeExactlyHalf", RoundHalfDown, i64(-5), i64(10), false},
-		{"RoundHalfDown_NegativeMoreThanHalf", RoundHalfDown, i64(-6), i64(10), true},
+		{"RoundFloor_PositiveRemainder", RoundFloor, i64(0), i64(3), i64(10), false},
+		{"RoundFloor_NegativeRemainder", RoundFloor, i64(0), i64(-3), i64(10), true},
+		{"RoundFloor_NoRemainder", RoundFloor, i64(0), i64(0), i64(10), false},
+
+		// RoundHalfUp: Rounds toward nearest neighbor, ties go away from zero
+		{"RoundHalfUp_LessThanHalf", RoundHalfUp, i64(0), i64(4), i64(10), false},
+		{"RoundHalfUp_ExactlyHalf", RoundHalfUp, i64(0), i64(5), i64(10), true},
+		{"RoundHalfUp_MoreThanHalf", RoundHalfUp, i64(0), i64(6), i64(10), true},
+		{"RoundHalfUp_NegativeLessThanHalf", RoundHalfUp, i64(0), i64(-4), i64(10), false},
+		{"RoundHalfUp_NegativeExactlyHalf", RoundHalfUp, i64(0), i64(-5), i64(10), true},
+		{"RoundHalfUp_NegativeMoreThanHalf", RoundHalfUp, i64(0), i64(-6), i64(10), true},
+
+		// RoundHalfDown: Rounds toward nearest neighbor, ties go toward zero
+		{"RoundHalfDown_LessThanHalf", RoundHalfDown, i64(0), i64(4), i64(10), false},
+		{"RoundHalfDown_ExactlyHalf", RoundHalfDown, i64(0), i64(5), i64(10), false},
+		{"RoundHalfDown_MoreThanHalf", RoundHalfDown, i64(0), i64(6), i64(10), true},
+		{"RoundHalfDown_NegativeLessThanHalf", RoundHalfDown, i64(0), i64(-4), i64(10), false},
+		{"RoundHalfDown_NegativeExactlyHalf", RoundHalfDown, i64(0), i64(-5), i64(10), false},
+		{"RoundHalfDown_NegativeMoreThanHalf", RoundHalfDown, i64(0), i64(-6), i64(10), true},
 
 		// RoundHalfEven: Rounds toward nearest neighbor, ties go to even neighbor
-		{"RoundHalfEven_LessThanHalf", RoundHalfEven, i64(4), i64(10), false},
-		{"RoundHalfEven_MoreThanHalf", RoundHalfEven, i64(6), i64(10), true},
-		{"RoundHalfEven_ExactlyHalf_OddRemainder", RoundHalfEven, i64(5), i64(10), true},  // 0.5 rounds up to 1 (odd)
-		{"RoundHalfEven_ExactlyHalf_EvenRemainder", RoundHalfEven, i64(2), i64(4), false}, // 0.5 rounds down to 0 (even)
-		{"RoundHalfEven_NegativeLessThanHalf", RoundHalfEven, i64(-4), i64(10), false},
-		{"RoundHalfEven_NegativeMoreThanHalf", RoundHalfEven, i64(-6), i64(10), true},
-		{"RoundHalfEven_NegativeExactlyHalf_OddRemainder", RoundHalfEven, i64(-5), i64(10), true},
-		{"RoundHalfEven_NegativeExactlyHalf_EvenRemainder", RoundHalfEven, i64(-2), i64(4), false},
+		{"RoundHalfEven_LessThanHalf", RoundHalfEven, i64(0), i64(4), i64(10), false},
+		{"RoundHalfEven_MoreThanHalf", RoundHalfEven, i64(0), i64(6), i64(10), true},
+		{"RoundHalfEven_ExactlyHalf_OddQuotient", RoundHalfEven, i64(1), i64(5), i64(10), true},  // 1.5 rounds up to 2 (even)
+		{"RoundHalfEven_ExactlyHalf_EvenQuotient", RoundHalfEven, i64(0), i64(2), i64(4), false}, // 0.5 rounds down to 0 (even)
+		{"RoundHalfEven_NegativeLessThanHalf", RoundHalfEven, i64(0), i64(-4), i64(10), false},
+		{"RoundHalfEven_NegativeMoreThanHalf", RoundHalfEven, i64(0), i64(-6), i64(10), true},
+		{"RoundHalfEven_NegativeExactlyHalf_OddQuotient", RoundHalfEven, i64(-1), i64(-5), i64(10), true},
+		{"RoundHalfEven_NegativeExactlyHalf_EvenQuotient", RoundHalfEven, i64(0), i64(-2), i64(4), false},
+		{"RoundHalfEven_ExactlyHalf_EvenQuotientOddRemainder", RoundHalfEven, i64(2), i64(5), i64(10), false}, // 2.5 rounds down to 2
+		{"RoundHalfEven_ExactlyHalf_OddQuotientEvenRemainder", RoundHalfEven, i64(3), i64(2), i64(4), true},   // 3.5 rounds up to 4
+		{"RoundHalfEven_NegativeExactlyHalf_EvenQuotientOddRemainder", RoundHalfEven, i64(-2), i64(-5), i64(10), false},
+
+		// Odd denominators have no exact half, so one below the midpoint is less than half
+		{"RoundHalfUp_OddDenominatorBelowHalf", RoundHalfUp, i64(0), i64(1), i64(3), false},
+		{"RoundHalfUp_OddDenominatorAboveHalf", RoundHalfUp, i64(0), i64(2), i64(3), true},
+		{"RoundHalfEven_OddDenominatorBelowHalf", RoundHalfEven, i64(1), i64(-3), i64(7), false},
+
+		// RoundHalfCeiling: Rounds toward nearest neighbor, ties go toward positive infinity
+		{"RoundHalfCeiling_LessThanHalf", RoundHalfCeiling, i64(0), i64(4), i64(10), false},
+		{"RoundHalfCeiling_ExactlyHalf", RoundHalfCeiling, i64(0), i64(5), i64(10), true},
+		{"RoundHalfCeiling_MoreThanHalf", RoundHalfCeiling, i64(0), i64(6), i64(10), true},
+		{"RoundHalfCeiling_NegativeLessThanHalf", RoundHalfCeiling, i64(0), i64(-4), i64(10), false},
+		{"RoundHalfCeiling_NegativeExactlyHalf", RoundHalfCeiling, i64(0), i64(-5), i64(10), false},
+		{"RoundHalfCeiling_NegativeMoreThanHalf", RoundHalfCeiling, i64(0), i64(-6), i64(10), true},
+
+		// RoundHalfFloor: Rounds toward nearest neighbor, ties go toward negative infinity
+		{"RoundHalfFloor_LessThanHalf", RoundHalfFloor, i64(0), i64(4), i64(10), false},
+		{"RoundHalfFloor_ExactlyHalf", RoundHalfFloor, i64(0), i64(5), i64(10), false},
+		{"RoundHalfFloor_MoreThanHalf", RoundHalfFloor, i64(0), i64(6), i64(10), true},
+		{"RoundHalfFloor_NegativeLessThanHalf", RoundHalfFloor, i64(0), i64(-4), i64(10), false},
+		{"RoundHalfFloor_NegativeExactlyHalf", RoundHalfFloor, i64(0), i64(-5), i64(10), true},
+		{"RoundHalfFloor_NegativeMoreThanHalf", RoundHalfFloor, i64(0), i64(-6), i64(10), true},
+
+		// RoundHalfOdd: Rounds toward nearest neighbor, ties go to odd neighbor
+		{"RoundHalfOdd_LessThanHalf", RoundHalfOdd, i64(0), i64(4), i64(10), false},
+		{"RoundHalfOdd_MoreThanHalf", RoundHalfOdd, i64(1), i64(6), i64(10), true},
+		{"RoundHalfOdd_ExactlyHalf_EvenQuotient", RoundHalfOdd, i64(2), i64(5), i64(10), true}, // 2.5 rounds up to 3 (odd)
+		{"RoundHalfOdd_ExactlyHalf_OddQuotient", RoundHalfOdd, i64(1), i64(5), i64(10), false}, // 1.5 rounds down to 1 (odd)
+		{"RoundHalfOdd_ExactlyHalf_EvenQuotientEvenRemainder", RoundHalfOdd, i64(0), i64(2), i64(4), true},
+		{"RoundHalfOdd_NegativeExactlyHalf_EvenQuotient", RoundHalfOdd, i64(-2), i64(-5), i64(10), true},
+		{"RoundHalfOdd_NegativeExactlyHalf_OddQuotient", RoundHalfOdd, i64(-1), i64(-5), i64(10), false},
+
+		// Round05Up: Rounds toward zero unless the retained digit is 0 or 5
+		{"Round05Up_RetainedZero", Round05Up, i64(120), i64(1), i64(10), true},
+		{"Round05Up_RetainedFive", Round05Up, i64(125), i64(9), i64(10), true},
+		{"Round05Up_RetainedOther", Round05Up, i64(123), i64(9), i64(10), false},
+		{"Round05Up_NegativeRetainedFive", Round05Up, i64(-15), i64(-1), i64(10), true},
+		{"Round05Up_NegativeRetainedOther", Round05Up, i64(-14), i64(-9), i64(10), false},
+		{"Round05Up_NoRemainder", Round05Up, i64(120), i64(0), i64(10), false},
 	}
 
 	for _, tc := range testCases {
 		t.Run(fmt.Sprintf("%s", tc.name), func(t *testing.T) {
-			result := tc.mode.shouldRoundUp(tc.rem, tc.denom)
+			result := tc.mode.shouldRoundUp(tc.quo, tc.rem, tc.denom)
 			if result != tc.expected {
 				t.Errorf("shouldRoundUp(%v, %v) with mode %s = %t; want %t", tc.rem, tc.denom, tc.mode, result, tc.expected)
 			}
@@ -77,6 +120,32 @@ func TestRoundingMode_shouldRoundUp(t *testing.T) {
 	}
 }
 
+func TestRoundingMode_String(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		want string
+	}{
+		{RoundDown, "RoundDown"},
+		{RoundUp, "RoundUp"},
+		{RoundCeiling, "RoundCeiling"},
+		{RoundFloor, "RoundFloor"},
+		{RoundHalfUp, "RoundHalfUp"},
+		{RoundHalfDown, "RoundHalfDown"},
+		{RoundHalfEven, "RoundHalfEven"},
+		{RoundUnnecessary, "RoundUnnecessary"},
+		{RoundHalfCeiling, "RoundHalfCeiling"},
+		{RoundHalfFloor, "RoundHalfFloor"},
+		{RoundHalfOdd, "RoundHalfOdd"},
+		{Round05Up, "Round05Up"},
+		{RoundingMode(99), "RoundingMode(99)"},
+	}
+	for _, tt := range tests {
+		if got := tt.mode.String(); got != tt.want {
+			t.Errorf("RoundingMode(%d).String() = %q, want %q", int(tt.mode), got, tt.want)
+		}
+	}
+}
+
 // TestRoundingMode_UnnecessaryPanic tests that RoundUnnecessary panics when rounding is required.
 func TestRoundingMode_UnnecessaryPanic(t *testing.T) {
 	rem := big.NewInt(1)
@@ -89,5 +158,323 @@ func TestRoundingMode_UnnecessaryPanic(t *testing.T) {
 		}
 	}()
 
-	mode.shouldRoundUp(rem, denom)
+	mode.shouldRoundUp(big.NewInt(0), rem, denom)
+}
+
+func TestDecimal_Round(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		scale     int32
+		mode      RoundingMode
+		wantVal   string
+		wantScale int32
+	}{
+		{"2.675 HalfUp", "2.675", 2, RoundHalfUp, "268", 2},
+		{"2.675 HalfEven", "2.675", 2, RoundHalfEven, "268", 2},
+		{"2.665 HalfDown", "2.665", 2, RoundHalfDown, "266", 2},
+		{"3.5 HalfEven", "3.5", 0, RoundHalfEven, "4", 0},
+		{"-2.675 HalfUp", "-2.675", 2, RoundHalfUp, "-268", 2},
+		{"1.21 Up", "1.21", 1, RoundUp, "13", 1},
+		{"-1.21 Up", "-1.21", 1, RoundUp, "-13", 1},
+		{"1.29 Down", "1.29", 1, RoundDown, "12", 1},
+		{"-1.29 Down", "-1.29", 1, RoundDown, "-12", 1},
+		{"1.21 Ceiling", "1.21", 1, RoundCeiling, "13", 1},
+		{"-1.29 Ceiling", "-1.29", 1, RoundCeiling, "-12", 1},
+		{"1.29 Floor", "1.29", 1, RoundFloor, "12", 1},
+		{"-1.21 Floor", "-1.21", 1, RoundFloor, "-13", 1},
+		{"several digits dropped", "1.23456", 2, RoundHalfUp, "123", 2},
+		{"carry", "9.999", 2, RoundHalfUp, "1000", 2},
+		{"to negative scale", "1250", -2, RoundHalfEven, "12", -2},
+		{"pad with zeros", "1.5", 3, RoundHalfUp, "1500", 3},
+		{"same scale", "1.55", 2, RoundDown, "155", 2},
+		{"exact", "1.50", 1, RoundUnnecessary, "15", 1},
+		{"2.665 HalfEven", "2.665", 2, RoundHalfEven, "266", 2},
+		{"-2.665 HalfEven", "-2.665", 2, RoundHalfEven, "-266", 2},
+		{"2.5 HalfEven", "2.5", 0, RoundHalfEven, "2", 0},
+		{"0.5 HalfEven", "0.5", 0, RoundHalfEven, "0", 0},
+		{"2.5 HalfCeiling", "2.5", 0, RoundHalfCeiling, "3", 0},
+		{"-2.5 HalfCeiling", "-2.5", 0, RoundHalfCeiling, "-2", 0},
+		{"-2.51 HalfCeiling", "-2.51", 0, RoundHalfCeiling, "-3", 0},
+		{"1.245 HalfCeiling", "1.245", 2, RoundHalfCeiling, "125", 2},
+		{"-1.245 HalfCeiling", "-1.245", 2, RoundHalfCeiling, "-124", 2},
+		{"2.5 HalfFloor", "2.5", 0, RoundHalfFloor, "2", 0},
+		{"2.51 HalfFloor", "2.51", 0, RoundHalfFloor, "3", 0},
+		{"-2.5 HalfFloor", "-2.5", 0, RoundHalfFloor, "-3", 0},
+		{"1.245 HalfFloor", "1.245", 2, RoundHalfFloor, "124", 2},
+		{"-1.245 HalfFloor", "-1.245", 2, RoundHalfFloor, "-125", 2},
+		{"0.5 HalfOdd", "0.5", 0, RoundHalfOdd, "1", 0},
+		{"1.5 HalfOdd", "1.5", 0, RoundHalfOdd, "1", 0},
+		{"2.5 HalfOdd", "2.5", 0, RoundHalfOdd, "3", 0},
+		{"-2.5 HalfOdd", "-2.5", 0, RoundHalfOdd, "-3", 0},
+		{"2.51 HalfOdd", "2.51", 0, RoundHalfOdd, "3", 0},
+		{"1.49 HalfOdd", "1.49", 0, RoundHalfOdd, "1", 0},
+		{"1.2450 HalfOdd", "1.2450", 2, RoundHalfOdd, "125", 2},
+		{"1.2350 HalfOdd", "1.2350", 2, RoundHalfOdd, "123", 2},
+		// The round-05up examples from the General Decimal Arithmetic tests
+		{"12.301 05Up", "12.301", 1, Round05Up, "123", 1},
+		{"12.001 05Up", "12.001", 1, Round05Up, "121", 1},
+		{"12.501 05Up", "12.501", 1, Round05Up, "126", 1},
+		{"12.99 05Up", "12.99", 1, Round05Up, "129", 1},
+		{"-12.001 05Up", "-12.001", 1, Round05Up, "-121", 1},
+		{"-12.46 05Up", "-12.46", 1, Round05Up, "-124", 1},
+		{"12.0 05Up exact", "12.00", 1, Round05Up, "120", 1},
+		{"0.4 05Up", "0.4", 0, Round05Up, "1", 0},
+		{"1.9 05Up", "1.9", 0, Round05Up, "1", 0},
+		{"5.01 05Up", "5.01", 0, Round05Up, "6", 0}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.Round(tt.scale, tt.mode)
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+				t.Errorf("Round(%d, %s) = %v scale %d, want %v scale %d",
+					tt.scale, tt.mode, got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+			}
+		})
+	}
+}
+
+func TestDecimal_RoundUnnecessaryPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	New(125, 2).Round(1, RoundUnnecessary)
+}
+
+func TestDecimal_CeilToSignificance(t *testing.T) {
+	tests := []struct {
+		input     string
+		scale     int32
+		wantVal   string
+		wantScale int32
+	}{
+		{"10.001", 2, "1001", 2},
+		{"10.01", 2, "1001", 2},
+		{"0.0001", 2, "1", 2},
+		{"-10.009", 2, "-1000", 2},
+		{"-0.001", 2, "0", 2},
+		{"12.5", 0, "13", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.CeilToSignificance(tt.scale)
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+				t.Errorf("CeilToSignificance(%d) = %v scale %d, want %v scale %d",
+					tt.scale, got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+			}
+		})
+	}
+}
+
+func TestDecimal_Truncate(t *testing.T) {
+	tests := []struct {
+		input     string
+		scale     int32
+		wantVal   string
+		wantScale int32
+	}{
+		{"1.99", 0, "1", 0},
+		{"-1.99", 0, "-1", 0},
+		{"-1.5", 0, "-1", 0},
+		{"-0.99", 0, "0", 0},
+		{"-123.456", 2, "-12345", 2},
+		{"123.456", 1, "1234", 1},
+		{"-1999", -3, "-1", -3},
+		{"1.5", 3, "15", 1},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s_%d", tt.input, tt.scale), func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.Truncate(tt.scale)
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+				t.Errorf("Truncate(%d) = %v scale %d, want %v scale %d",
+					tt.scale, got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+			}
+		})
+	}
+}
+
+func TestDecimal_RoundToSignificantDigits(t *testing.T) {
+	tests := []struct {
+		input     string
+		digits    int32
+		mode      RoundingMode
+		wantVal   string
+		wantScale int32
+	}{
+		{"123456", 3, RoundHalfUp, "123", -3},
+		{"-123456", 3, RoundHalfUp, "-123", -3},
+		{"123567", 3, RoundHalfUp, "124", -3},
+		{"123567", 3, RoundDown, "123", -3},
+		{"-123567", 3, RoundFloor, "-124", -3},
+		{"0.0012345", 2, RoundHalfUp, "12", 4},
+		{"-0.0012345", 2, RoundCeiling, "-12", 4},
+		{"0.0012345", 4, RoundHalfDown, "1234", 6},
+		{"9.99", 2, RoundHalfUp, "10", 0},
+		{"-9.99", 2, RoundHalfUp, "-10", 0},
+		{"99999", 1, RoundUp, "1", -5},
+		{"1.5E+20", 1, RoundHalfUp, "2", -20},
+		{"12.5", 5, RoundHalfUp, "125", 1},
+		{"12.500", 3, RoundUnnecessary, "125", 1},
+		{"0", 3, RoundHalfUp, "0", 0},
+		{"0.000", 1, RoundHalfUp, "0", 3},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s_%d_%v", tt.input, tt.digits, tt.mode), func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.RoundToSignificantDigits(tt.digits, tt.mode)
+			if got.unscaledValue.String() != tt.wantVal || got.scale != tt.wantScale {
+				t.Errorf("RoundToSignificantDigits(%d, %v) = %v scale %d, want %v scale %d",
+					tt.digits, tt.mode, got.unscaledValue, got.scale, tt.wantVal, tt.wantScale)
+			}
+		})
+	}
+}
+
+func TestDecimal_RoundToSignificantDigitsPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected a panic but did not get one")
+		}
+	}()
+	New(123, 0).RoundToSignificantDigits(0, RoundHalfUp)
+}
+
+func TestDecimal_ExplainRound(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		scale int32
+		mode  RoundingMode
+		want  []string
+	}{
+		{"half even tie moved", "3.5", 0, RoundHalfEven, []string{"a tie", "3 is odd", "giving 4"}},
+		{"half up tie", "-2.5", 0, RoundHalfUp, []string{"a tie", "ties away from zero", "giving -3"}},
+		{"half even tie kept", "2.5", 0, RoundHalfEven, []string{"dropped digits 5", "a tie", "toward the even neighbour", "2 is even", "giving 2"}},
+		{"nearest", "1.234", 1, RoundHalfUp, []string{"dropped digits 34", "less than half", "giving 1.2"}},
+		{"leading zero digits", "1.2004", 1, RoundCeiling, []string{"dropped digits 004", "away from zero", "giving 1.3"}},
+		{"floor negative", "-1.21", 1, RoundFloor, []string{"away from zero for this value", "giving -1.3"}},
+		{"exact", "1.20", 1, RoundUp, []string{"all zero", "exactly 1.2"}},
+		{"no digits dropped", "1.5", 2, RoundDown, []string{"no digits are dropped", "1.50"}},
+		{"unnecessary", "1.21", 1, RoundUnnecessary, []string{"would panic"}},
+		{"half ceiling negative tie", "-2.5", 0, RoundHalfCeiling, []string{"a tie", "toward zero for this value", "giving -2"}},
+		{"half floor negative tie", "-2.5", 0, RoundHalfFloor, []string{"a tie", "away from zero for this value", "giving -3"}},
+		{"half odd tie moved", "2.5", 0, RoundHalfOdd, []string{"a tie", "2 is even", "giving 3"}},
+		{"half odd tie kept", "1.5", 0, RoundHalfOdd, []string{"a tie", "1 is odd", "giving 1"}},
+		{"05 up moved", "12.501", 1, Round05Up, []string{"and 5 is, so it moves", "giving 12.6"}},
+		{"05 up kept", "12.34", 1, Round05Up, []string{"and 3 is neither", "giving 12.3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.ExplainRound(tt.scale, tt.mode)
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("ExplainRound(%d, %s) = %q, want it to mention %q", tt.scale, tt.mode, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecimal_FloorCeil(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantFloor string
+		wantCeil  string
+	}{
+		{"1.1", "1", "2"},
+		{"-1.1", "-2", "-1"},
+		{"1.9", "1", "2"},
+		{"-1.9", "-2", "-1"},
+		{"0.5", "0", "1"},
+		{"-0.5", "-1", "0"},
+		{"5", "5", "5"},
+		{"-5.000", "-5", "-5"},
+		{"1.2e+3", "1200", "1200"},
+		{"-7e+2", "-700", "-700"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			floor := d.Floor()
+			if floor.String() != tt.wantFloor || floor.scale != 0 {
+				t.Errorf("Floor(%s) = %v scale %d, want %v scale 0", tt.input, floor, floor.scale, tt.wantFloor)
+			}
+			ceil := d.Ceil()
+			if ceil.String() != tt.wantCeil || ceil.scale != 0 {
+				t.Errorf("Ceil(%s) = %v scale %d, want %v scale 0", tt.input, ceil, ceil.scale, tt.wantCeil)
+			}
+		})
+	}
+}
+
+func TestDecimal_DistanceToInteger(t *testing.T) {
+	tests := []struct {
+		input     string
+		want      string
+		wantScale int32
+	}{
+		{"2.3", "0.3", 1},
+		{"2.7", "-0.3", 1},
+		{"2.499", "0.499", 3},
+		{"2.501", "-0.499", 3},
+		{"2.5", "0.5", 1},
+		{"-2.5", "-0.5", 1},
+		{"-2.3", "-0.3", 1},
+		{"-2.7", "0.3", 1},
+		{"-0.501", "0.499", 3},
+		{"3.00", "0.00", 2},
+		{"12", "0", 0},
+		{"1.2e+3", "0", -2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got := d.DistanceToInteger()
+			if got.String() != tt.want || got.scale != tt.wantScale {
+				t.Errorf("DistanceToInteger() = %v scale %d, want %v scale %d", got, got.scale, tt.want, tt.wantScale)
+			}
+		})
+	}
+}
+
+func TestDecimal_ZeroValueRound(t *testing.T) {
+	var zero Decimal
+	if got := zero.Round(2, RoundHalfUp); got.String() != "0.00" {
+		t.Errorf("Round(2) = %v, want 0.00", got)
+	}
+	if got := zero.Truncate(0); got.String() != "0" {
+		t.Errorf("Truncate(0) = %v, want 0", got)
+	}
+	if got := zero.RoundToSignificantDigits(3, RoundHalfUp); got.String() != "0" {
+		t.Errorf("RoundToSignificantDigits(3) = %v, want 0", got)
+	}
+	if got := zero.ExplainRound(1, RoundUp); !strings.Contains(got, "exactly 0.0") {
+		t.Errorf("ExplainRound(1) = %q, want it to mention exactly 0.0", got)
+	}
 }
@@ -0,0 +1,135 @@
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+)
+
+// Range returns the values start, start+step, start+2*step, ... up to but
+// excluding stop, much like a numeric for loop. Every element carries the
+// larger of start's and step's scale, so Range(0, 1, 0.25) yields
+// [0.00, 0.25, 0.50, 0.75]. A zero step, or a step pointing away from stop,
+// returns an error.
+func Range(start, stop, step Decimal) ([]Decimal, error) {
+	if step.IsZero() {
+		return nil, fmt.Errorf("range step must not be zero")
+	}
+	direction := stop.Cmp(start)
+	if direction == 0 {
+		return nil, nil
+	}
+	if direction != step.Sign() {
+		return nil, fmt.Errorf("range step %s moves away from stop %s", step, stop)
+	}
+
+	scale := max(start.scale, step.scale)
+	var values []Decimal
+	for current := start.rescale(scale); stop.Cmp(current) == direction; current = current.Add(step) {
+		values = append(values, current)
+	}
+	return values, nil
+}
+
+// CommonScale returns the largest scale among values, the smallest scale at
+// which all of them can be represented exactly. It returns 0 for an empty
+// slice.
+func CommonScale(values []Decimal) int32 {
+	if len(values) == 0 {
+		return 0
+	}
+	scale := values[0].scale
+	for _, v := range values[1:] {
+		scale = max(scale, v.scale)
+	}
+	return scale
+}
+
+// UnifyScale returns a new slice holding values rescaled to their
+// CommonScale, together with that scale, so a column can be written with one
+// uniform representation. Rescaling only ever adds trailing zeros, so every
+// value is unchanged. The input slice is not modified.
+func UnifyScale(values []Decimal) ([]Decimal, int32) {
+	scale := CommonScale(values)
+	unified := make([]Decimal, len(values))
+	for i, v := range values {
+		unified[i] = v.rescale(scale)
+	}
+	return unified, scale
+}
+
+// key returns a string that is identical for numerically equal Decimals
+// regardless of scale, built from the Normalize form.
+func (d Decimal) key() string {
+	n := d.Normalize()
+	return n.unscaledValue.String() + "e" + strconv.Itoa(int(-n.scale))
+}
+
+// Distinct returns the numerically distinct elements of values in order of
+// first appearance; later elements equal to an earlier one are dropped, so
+// [1.0, 2, 1.00, 2.0] gives [1.0, 2]. The input slice is not modified.
+func Distinct(values []Decimal) []Decimal {
+	seen := make(map[string]struct{}, len(values))
+	var distinct []Decimal
+	for _, v := range values {
+		k := v.key()
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		distinct = append(distinct, v)
+	}
+	return distinct
+}
+
+// DotProduct returns the sum of a[i]*b[i], exactly and at the largest
+// scale among the products, so [1.5, 2] · [2, 0.25] is 3.50. The products
+// are accumulated into a single big.Int with one scratch value rather than
+// a Decimal per element. Empty slices give 0. It returns an error if the
+// lengths differ or a product's scale overflows.
+func DotProduct(a, b []Decimal) (Decimal, error) {
+	if len(a) != len(b) {
+		return Decimal{}, fmt.Errorf("dot product of slices with lengths %d and %d", len(a), len(b))
+	}
+	scales := make([]int32, len(a))
+	scale := int32(math.MinInt32)
+	for i := range a {
+		s, err := productScale(a[i].orZero().scale, b[i].orZero().scale)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("product %d: %w", i, err)
+		}
+		scales[i] = s
+		scale = max(scale, s)
+	}
+	if len(a) == 0 {
+		return Decimal{unscaledValue: new(big.Int), scale: 0}, nil
+	}
+
+	sum, term := new(big.Int), new(big.Int)
+	for i := range a {
+		term.Mul(a[i].orZero().unscaledValue, b[i].orZero().unscaledValue)
+		if scales[i] < scale {
+			term.Mul(term, pow10(scale-scales[i]))
+		}
+		sum.Add(sum, term)
+	}
+	return Decimal{unscaledValue: sum, scale: scale}, nil
+}
+
+// DecimalSlice attaches the methods of sort.Interface to []Decimal, ordering
+// by numeric value with Cmp, so values at different scales compare correctly
+// and 1.5 and 1.50 are equal.
+type DecimalSlice []Decimal
+
+func (s DecimalSlice) Len() int           { return len(s) }
+func (s DecimalSlice) Less(i, j int) bool { return s[i].Cmp(s[j]) < 0 }
+func (s DecimalSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort sorts s in increasing order. The sort is stable, so equal values
+// such as 1.5 and 1.50 keep their relative order.
+func (s DecimalSlice) Sort() { sort.Stable(s) }
+
+// SortDesc sorts s in decreasing order, also stably.
+func (s DecimalSlice) SortDesc() { sort.Stable(sort.Reverse(s)) }
@@ -0,0 +1,184 @@
+package decimal
+
+import (
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		start     Decimal
+		stop      Decimal
+		step      Decimal
+		want      []string
+		wantScale int32
+		wantErr   bool
+	}{
+		{"quarters", New(0, 0), New(1, 0), New(25, 2), []string{"0.00", "0.25", "0.50", "0.75"}, 2, false},
+		{"integers", New(1, 0), New(4, 0), New(1, 0), []string{"1", "2", "3"}, 0, false},
+		{"stop not on step", New(0, 0), New(1, 0), New(3, 1), []string{"0.0", "0.3", "0.6", "0.9"}, 1, false},
+		{"descending", New(1, 0), New(0, 0), New(-5, 1), []string{"1.0", "0.5"}, 1, false},
+		{"negative start", New(-1, 0), New(1, 0), New(1, 0), []string{"-1", "0"}, 0, false},
+		{"start scale wins", New(100, 2), New(3, 0), New(1, 0), []string{"1.00", "2.00"}, 2, false},
+		{"empty", New(1, 0), New(1, 0), New(1, 0), nil, 0, false},
+		{"zero step", New(0, 0), New(1, 0), New(0, 2), nil, 0, true},
+		{"wrong sign step", New(0, 0), New(1, 0), New(-1, 1), nil, 0, true},
+		{"wrong sign descending", New(1, 0), New(0, 0), New(1, 1), nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Range(tt.start, tt.stop, tt.step)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Range() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Range() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("Range()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+				if got[i].scale != tt.wantScale {
+					t.Errorf("Range()[%d] scale = %d, want %d", i, got[i].scale, tt.wantScale)
+				}
+			}
+		})
+	}
+}
+
+func TestUnifyScale(t *testing.T) {
+	tests := []struct {
+		name      string
+		values    []Decimal
+		want      []string
+		wantScale int32
+	}{
+		{"mixed scales", []Decimal{New(15, 1), New(2, 0), New(125, 3)}, []string{"1.500", "2.000", "0.125"}, 3},
+		{"negative scales", []Decimal{New(12, -2), New(3, -1)}, []string{"1200", "30"}, -1},
+		{"already uniform", []Decimal{New(100, 2), New(-250, 2)}, []string{"1.00", "-2.50"}, 2},
+		{"empty", nil, []string{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, scale := UnifyScale(tt.values)
+			if scale != tt.wantScale {
+				t.Errorf("UnifyScale() scale = %d, want %d", scale, tt.wantScale)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("UnifyScale() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].String() != tt.want[i] || got[i].scale != tt.wantScale {
+					t.Errorf("UnifyScale()[%d] = %v scale %d, want %v scale %d", i, got[i], got[i].scale, tt.want[i], tt.wantScale)
+				}
+				if !got[i].Equal(tt.values[i]) {
+					t.Errorf("UnifyScale()[%d] changed the value of %v", i, tt.values[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDistinct(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []Decimal
+		want   []string
+	}{
+		{"trailing zeros collapse", []Decimal{New(10, 1), New(2, 0), New(100, 2), New(20, 1)}, []string{"1.0", "2"}},
+		{"negative scale", []Decimal{New(12, -2), New(1200, 0), New(120000, 2)}, []string{"1200"}},
+		{"zeros at any scale", []Decimal{New(0, 3), New(0, 0), New(0, -2)}, []string{"0.000"}},
+		{"sign matters", []Decimal{New(15, 1), New(-15, 1), New(150, 2)}, []string{"1.5", "-1.5"}},
+		{"order preserved", []Decimal{New(3, 0), New(1, 0), New(2, 0), New(1, 0)}, []string{"3", "1", "2"}},
+		{"empty", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Distinct(tt.values)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Distinct() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("Distinct()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDecimalSlice_Sort(t *testing.T) {
+	values := DecimalSlice{
+		New(150, 2), New(-2, 0), New(15, 1), New(1, -1), New(0, 3), New(1499, 3), New(-20, 1), New(15, 1),
+	}
+	values.Sort()
+	want := []string{"-2", "-2.0", "0.000", "1.499", "1.50", "1.5", "1.5", "10"}
+	for i, v := range values {
+		if v.String() != want[i] {
+			t.Errorf("Sort() [%d] = %v, want %v (got %v)", i, v, want[i], values)
+		}
+	}
+
+	values.SortDesc()
+	want = []string{"10", "1.50", "1.5", "1.5", "1.499", "0.000", "-2", "-2.0"}
+	for i, v := range values {
+		if v.String() != want[i] {
+			t.Errorf("SortDesc() [%d] = %v, want %v (got %v)", i, v, want[i], values)
+		}
+	}
+}
+
+func TestDecimalSlice_LessMixedScales(t *testing.T) {
+	s := DecimalSlice{New(15, 1), New(150, 2)}
+	if s.Less(0, 1) || s.Less(1, 0) {
+		t.Errorf("Less() orders 1.5 and 1.50, want them equal")
+	}
+	if !sort.IsSorted(DecimalSlice{New(1, 100), New(1, -100)}) {
+		t.Errorf("IsSorted() = false for [1e-100, 1e100]")
+	}
+}
+
+func TestDotProduct(t *testing.T) {
+	tests := []struct {
+		name      string
+		a         []Decimal
+		b         []Decimal
+		want      string
+		wantScale int32
+		wantErr   bool
+	}{
+		{"mixed scales", []Decimal{New(15, 1), New(2, 0)}, []Decimal{New(2, 0), New(25, 2)}, "3.50", 2, false},
+		{"weighted average numerator", []Decimal{New(1000, 2), New(2000, 2), New(3000, 2)},
+			[]Decimal{New(1, 0), New(2, 0), New(3, 0)}, "140.00", 2, false},
+		{"negative terms cancel", []Decimal{New(3, 0), New(-3, 0)}, []Decimal{New(7, 1), New(7, 1)}, "0.0", 1, false},
+		{"negative scales", []Decimal{New(1, -2), New(5, 0)}, []Decimal{New(3, -1), New(1, 0)}, "3005", 0, false},
+		{"single", []Decimal{New(-12, 1)}, []Decimal{New(12, 1)}, "-1.44", 2, false},
+		{"empty", nil, []Decimal{}, "0", 0, false},
+		{"zero value elements", []Decimal{{}, New(2, 0)}, []Decimal{New(5, 1), {}}, "0.0", 1, false},
+		{"length mismatch", []Decimal{New(1, 0)}, nil, "", 0, true},
+		{"scale overflow", []Decimal{New(1, math.MaxInt32)}, []Decimal{New(1, 1)}, "", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DotProduct(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DotProduct() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.String() != tt.want || got.scale != tt.wantScale {
+				t.Errorf("DotProduct() = %v scale %d, want %v scale %d", got, got.scale, tt.want, tt.wantScale)
+			}
+			want := Zero
+			for i := range tt.a {
+				want = want.Add(tt.a[i].Multiply(tt.b[i]))
+			}
+			if !got.Equal(want) {
+				t.Errorf("DotProduct() = %v, want the sum of products %v", got, want)
+			}
+		})
+	}
+}
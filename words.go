@@ -0,0 +1,94 @@
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	englishOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+		"seventeen", "eighteen", "nineteen",
+	}
+	englishTens = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+	// englishGroups names each group of three digits, from the units upwards
+	englishGroups = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+	}
+)
+
+// Words spells out d the way the amount line of a check is written, e.g.
+// "one hundred twenty-three and 45/100" for 123.45. The cents are always
+// written as two digits over 100, so 5 gives "five and 00/100". Only English
+// ("en") is supported. It returns an error for other languages, negative
+// amounts, amounts with non-zero digits beyond the cents, and integer parts of
+// a sextillion or more, which have no group name.
+func (d Decimal) Words(lang string) (string, error) {
+	if lang != "en" {
+		return "", fmt.Errorf("unsupported language %q for Words", lang)
+	}
+	if d.Sign() < 0 {
+		return "", fmt.Errorf("cannot write negative amount %s in words", d)
+	}
+	trimmed := d.trimTrailingZeros(2)
+	if trimmed.scale > 2 {
+		return "", fmt.Errorf("amount %s has more than 2 fractional digits", d)
+	}
+
+	units, cents := new(big.Int).QuoRem(trimmed.rescale(2).unscaledValue, big.NewInt(100), new(big.Int))
+	if numDigits(units) > 3*len(englishGroups) {
+		return "", fmt.Errorf("amount %s is too large to write in words", d)
+	}
+	return fmt.Sprintf("%s and %02d/100", englishInteger(units), cents.Int64()), nil
+}
+
+// englishInteger spells out the non-negative integer n, which must have no
+// more digits than englishGroups can name.
+func englishInteger(n *big.Int) string {
+	if n.Sign() == 0 {
+		return englishOnes[0]
+	}
+
+	// Split into groups of three digits, least significant first
+	var groups []int64
+	thousand := big.NewInt(1000)
+	rest, group := new(big.Int).Set(n), new(big.Int)
+	for rest.Sign() > 0 {
+		rest.QuoRem(rest, thousand, group)
+		groups = append(groups, group.Int64())
+	}
+
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+		words = append(words, englishBelowThousand(groups[i]))
+		if englishGroups[i] != "" {
+			words = append(words, englishGroups[i])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// englishBelowThousand spells out n in [1, 999].
+func englishBelowThousand(n int64) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, englishOnes[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n >= 20 && n%10 != 0:
+		words = append(words, englishTens[n/10]+"-"+englishOnes[n%10])
+	case n >= 20:
+		words = append(words, englishTens[n/10])
+	case n > 0:
+		words = append(words, englishOnes[n])
+	}
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,46 @@
+package decimal
+
+import (
+	"testing"
+)
+
+func TestDecimal_Words(t *testing.T) {
+	tests := []struct {
+		input   string
+		lang    string
+		want    string
+		wantErr bool
+	}{
+		{"123.45", "en", "one hundred twenty-three and 45/100", false},
+		{"5", "en", "five and 00/100", false},
+		{"0.07", "en", "zero and 07/100", false},
+		{"0", "en", "zero and 00/100", false},
+		{"1234.50", "en", "one thousand two hundred thirty-four and 50/100", false},
+		{"1000000", "en", "one million and 00/100", false},
+		{"2001015.99", "en", "two million one thousand fifteen and 99/100", false},
+		{"90", "en", "ninety and 00/100", false},
+		{"1.5000", "en", "one and 50/100", false},
+		{"999999999999999999999.99", "en", "nine hundred ninety-nine quintillion nine hundred ninety-nine quadrillion " +
+			"nine hundred ninety-nine trillion nine hundred ninety-nine billion nine hundred ninety-nine million " +
+			"nine hundred ninety-nine thousand nine hundred ninety-nine and 99/100", false},
+		{"1e+21", "en", "", true},
+		{"1.005", "en", "", true},
+		{"-5", "en", "", true},
+		{"5", "fr", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input+" "+tt.lang, func(t *testing.T) {
+			d, err := NewFromString(tt.input)
+			if err != nil {
+				t.Fatalf("NewFromString(%q) unexpected error: %v", tt.input, err)
+			}
+			got, err := d.Words(tt.lang)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Words(%q) error = %v, wantErr %v", tt.lang, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Words(%q) = %q, want %q", tt.lang, got, tt.want)
+			}
+		})
+	}
+}